@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// showAuditLog shows audit events of the user page by page, newest first.
+func showAuditLog(ctx context.Context, client pb.AuditClient) {
+	days := getNumberFromUser("Show events of the last N days", 7)
+	req := &pb.ListAuditEventsRequest{
+		From: timestamppb.New(time.Now().AddDate(0, 0, -int(days))),
+	}
+	if action := getAuditAction(); action != 0 {
+		req.Actions = []pb.AuditAction{pb.AuditAction(action)}
+	}
+
+	for {
+		resp, err := client.ListAuditEvents(ctx, req)
+		if err != nil {
+			fmt.Println("Cant get your audit log!")
+			log.Error().Err(err).Msg("cant list audit events from server")
+			return
+		}
+		if len(resp.Events) == 0 && req.Cursor == 0 {
+			fmt.Println("No events")
+		}
+
+		for _, event := range resp.Events {
+			fmt.Printf(
+				"%s %s%s %s from %s: %s\n",
+				event.CreatedAt.AsTime().Local().Format(requestTimeLayout),
+				formatAuditActor(event),
+				models.AuditAction(event.Action),
+				formatAuditSecret(event),
+				event.ClientAddress,
+				event.Outcome,
+			)
+		}
+
+		if resp.NextCursor == 0 || !confirm("Show older events") {
+			return
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+// formatAuditActor returns who made the action in the vault of the user or in collection.
+func formatAuditActor(event *pb.AuditEvent) string {
+	actor := ""
+	if event.Actor != "" {
+		actor = event.Actor + ": "
+	}
+	if event.CollectionId != 0 {
+		actor = fmt.Sprintf("collection #%d %s", event.CollectionId, actor)
+	}
+	return actor
+}
+
+func formatAuditSecret(event *pb.AuditEvent) string {
+	switch {
+	case event.SecretName != "":
+		return fmt.Sprintf("%s (%s)", event.SecretName, models.SecretType(event.SecretType))
+	case event.SecretId != 0:
+		return fmt.Sprintf("secret #%d", event.SecretId)
+	}
+	return event.Method
+}
+
+// getAuditAction returns action chosen by user, zero action means all actions.
+func getAuditAction() models.AuditAction {
+	actions := []models.AuditAction{
+		0,
+		models.AuditActionLogin,
+		models.AuditActionRegister,
+		models.AuditActionCreate,
+		models.AuditActionRead,
+		models.AuditActionUpdate,
+		models.AuditActionDelete,
+		models.AuditActionShare,
+		models.AuditActionReveal,
+	}
+	items := []string{"All actions"}
+	for _, action := range actions[1:] {
+		items = append(items, action.String())
+	}
+
+	prompt := promptui.Select{
+		Label: "Choose action",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose action prompt failed")
+	}
+
+	return actions[idx]
+}
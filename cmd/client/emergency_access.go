@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+// requestTimeLayout shows time of emergency access request, wait periods are counted in hours.
+const requestTimeLayout = "2006-01-02 15:04"
+
+func manageEmergencyAccess(ctx context.Context, client pb.EmergencyAccessClient) {
+	prompt := promptui.Select{
+		Label: "Emergency access",
+		Items: []string{
+			"My emergency contacts",
+			"Nominate contact",
+			"Approve request",
+			"Reject request",
+			"Revoke contact",
+			"Vaults I can access in emergency",
+			"Request emergency access",
+			"Back",
+		},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("emergency access prompt failed")
+	}
+
+	switch idx {
+	case 0:
+		resp, err := client.ListContacts(ctx, &pb.ListContactsRequest{})
+		if err != nil {
+			fmt.Println("Cant get your emergency contacts!")
+			log.Error().Err(err).Msg("cant list emergency contacts from server")
+			return
+		}
+		printEmergencyContacts(resp.Contacts, func(contact *pb.EmergencyContact) string { return contact.Contact })
+	case 1:
+		_, err = client.NominateContact(ctx, &pb.NominateContactRequest{
+			Username:  getValueFromUser("Enter username"),
+			WaitHours: getNumberFromUser("How many hours to wait before access is granted", 48),
+		})
+		printEmergencyResult(err, "Contact nominated!", "Cant nominate contact!")
+	case 2:
+		_, err = client.ApproveRequest(ctx, &pb.ContactRequest{Username: getValueFromUser("Enter username")})
+		printEmergencyResult(err, "Access approved!", "Cant approve access!")
+	case 3:
+		_, err = client.RejectRequest(ctx, &pb.ContactRequest{Username: getValueFromUser("Enter username")})
+		printEmergencyResult(err, "Access rejected!", "Cant reject access!")
+	case 4:
+		_, err = client.RevokeContact(ctx, &pb.ContactRequest{Username: getValueFromUser("Enter username")})
+		printEmergencyResult(err, "Contact revoked!", "Cant revoke contact!")
+	case 5:
+		resp, err := client.ListGrantors(ctx, &pb.ListContactsRequest{})
+		if err != nil {
+			fmt.Println("Cant get vaults!")
+			log.Error().Err(err).Msg("cant list emergency grantors from server")
+			return
+		}
+		printEmergencyContacts(resp.Contacts, func(contact *pb.EmergencyContact) string { return contact.Owner })
+	case 6:
+		contact, err := client.RequestAccess(ctx, &pb.RequestAccessRequest{Owner: getValueFromUser("Enter username of the owner")})
+		if err != nil {
+			fmt.Println("Cant request access!")
+			log.Error().Err(err).Msg("cant request emergency access on server")
+			return
+		}
+		printEmergencyContacts([]*pb.EmergencyContact{contact}, func(contact *pb.EmergencyContact) string { return contact.Owner })
+	}
+}
+
+// printEmergencyContacts prints state of emergency access, name returns the other user of the access.
+func printEmergencyContacts(contacts []*pb.EmergencyContact, name func(contact *pb.EmergencyContact) string) {
+	if len(contacts) == 0 {
+		fmt.Println("No emergency contacts")
+	}
+
+	for _, contact := range contacts {
+		fmt.Printf("%s: %s, wait %d hours", name(contact), models.EmergencyAccessStatus(contact.Status), contact.WaitHours)
+		if contact.Status == pb.EmergencyAccessStatus_EMERGENCY_ACCESS_STATUS_REQUESTED && contact.RequestedAt != nil {
+			fmt.Printf(", requested at %s", contact.RequestedAt.AsTime().Local().Format(requestTimeLayout))
+		}
+		if contact.AccessGranted {
+			fmt.Print(", access granted")
+		}
+		fmt.Println()
+	}
+}
+
+func printEmergencyResult(err error, success string, failure string) {
+	if err != nil {
+		fmt.Println(failure)
+		log.Error().Err(err).Msg("cant manage emergency access on server")
+		return
+	}
+	fmt.Println(success)
+}
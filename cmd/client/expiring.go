@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+func showExpiring(ctx context.Context, client pb.SecretsClient) {
+	resp, err := client.ListExpiring(ctx, &pb.ListExpiringRequest{
+		WithinDays: getNumberFromUser("Show secrets that are due within days", 30),
+	})
+	if err != nil {
+		fmt.Println("Cant get your expiring secrets!")
+		log.Fatal().Err(err).Msg("cant list expiring secrets from server")
+	}
+	if len(resp.Secrets) == 0 {
+		fmt.Println("Nothing is due")
+		return
+	}
+
+	for _, secret := range resp.Secrets {
+		fmt.Printf(
+			"%s (%s): %s\n",
+			secret.Metadata.Name,
+			models.SecretType(secret.Metadata.Type),
+			formatDue(secret.Reason, secret.DueAt.AsTime()),
+		)
+	}
+}
+
+// showNotifications prints notifications that server recorded since the last login.
+func showNotifications(ctx context.Context, client pb.NotificationsClient) {
+	resp, err := client.ListNotifications(ctx, &pb.ListNotificationsRequest{})
+	if err != nil {
+		log.Error().Err(err).Msg("cant list notifications from server")
+		return
+	}
+	if len(resp.Notifications) == 0 {
+		return
+	}
+
+	fmt.Printf("You have %d new notifications:\n", len(resp.Notifications))
+	for _, notification := range resp.Notifications {
+		fmt.Printf(
+			"  - %s (%s): %s\n",
+			notification.SecretName,
+			models.SecretType(notification.SecretType),
+			formatDue(notification.Reason, notification.DueAt.AsTime()),
+		)
+	}
+}
+
+func formatDue(reason pb.DueReason, dueAt time.Time) string {
+	action := "expires"
+	if reason == pb.DueReason_DUE_REASON_ROTATION {
+		action = "needs rotation"
+	}
+
+	date := dueAt.Local().Format(expiryDateLayout)
+	if dueAt.Before(time.Now()) {
+		return fmt.Sprintf("%s, overdue since %s", action, date)
+	}
+	return fmt.Sprintf("%s on %s", action, date)
+}
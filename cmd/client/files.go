@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+const uploadChunkSize = 64 * 1024
+
+func uploadFile(ctx context.Context, client pb.SecretsClient, secretName string) {
+	path := getValueFromUser("Enter path to file")
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Println("Cant open file!")
+		log.Error().Err(err).Msg("cant open file for upload")
+		return
+	}
+	defer file.Close()
+
+	stream, err := client.UploadFile(ctx)
+	if err != nil {
+		fmt.Println("Cant upload your file!")
+		log.Fatal().Err(err).Msg("cant start file upload")
+	}
+
+	err = stream.Send(&pb.UploadFileRequest{
+		Data: &pb.UploadFileRequest_Info{Info: &pb.FileInfo{
+			Name:       secretName,
+			FileName:   filepath.Base(path),
+			Attributes: getAttributesFromUser(),
+		}},
+	})
+	if err != nil {
+		fmt.Println("Cant upload your file!")
+		log.Fatal().Err(err).Msg("cant send file info")
+	}
+
+	reader := bufio.NewReaderSize(file, uploadChunkSize)
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := reader.Read(buf)
+		if n > 0 {
+			err = stream.Send(&pb.UploadFileRequest{
+				Data: &pb.UploadFileRequest_Chunk{Chunk: buf[:n]},
+			})
+			if err != nil {
+				fmt.Println("Cant upload your file!")
+				log.Fatal().Err(err).Msg("cant send file chunk")
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			fmt.Println("Cant read your file!")
+			log.Fatal().Err(readErr).Msg("cant read file for upload")
+		}
+	}
+
+	info, err := stream.CloseAndRecv()
+	if err != nil {
+		fmt.Println("Cant upload your file!")
+		log.Fatal().Err(err).Msg("cant finish file upload")
+	}
+	fmt.Printf("File uploaded! Size: %d bytes, SHA-256: %s\n", info.Size, info.Checksum)
+}
+
+func downloadFile(ctx context.Context, client pb.SecretsClient, request *pb.GetSecretRequest) {
+	stream, err := client.DownloadFile(ctx, request)
+	if err != nil {
+		fmt.Println("Cant download your file!")
+		log.Fatal().Err(err).Msg("cant start file download")
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		fmt.Println("Cant download your file!")
+		log.Fatal().Err(err).Msg("cant receive file info")
+	}
+	info := resp.GetInfo()
+	if info == nil {
+		fmt.Println("Cant download your file!")
+		log.Fatal().Msg("server did not send file info")
+	}
+
+	path := getValueFromUserWithDefault("Save file to", info.FileName)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		fmt.Println("Cant create file! Maybe it already exists")
+		log.Error().Err(err).Msg("cant create file for download")
+		return
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	writer := io.MultiWriter(file, hash)
+	for {
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fmt.Println("Cant download your file!")
+			log.Fatal().Err(err).Msg("cant receive file chunk")
+		}
+
+		_, err = writer.Write(resp.GetChunk())
+		if err != nil {
+			fmt.Println("Cant write your file!")
+			log.Fatal().Err(err).Msg("cant write downloaded file")
+		}
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != info.Checksum {
+		fmt.Println("Warning! Checksum of downloaded file does not match checksum of uploaded file")
+		return
+	}
+	fmt.Printf("File saved to %s (%d bytes)\n", path, info.Size)
+}
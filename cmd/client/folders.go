@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+// browseFolders lets user walk through folders and open secrets until user goes back to the menu.
+func browseFolders(ctx context.Context, c clients) {
+	path := ""
+	for {
+		contents, err := c.folders.ListFolder(ctx, &pb.FolderRequest{Path: path})
+		if err != nil {
+			fmt.Println("Cant open folder!")
+			log.Error().Err(err).Msg("cant list folder on server")
+			if path == "" {
+				return
+			}
+			path = parentFolder(path)
+			continue
+		}
+
+		// every item has an action, so chosen index is mapped back to it
+		var items []string
+		var actions []func() (string, bool)
+
+		if path != "" {
+			items = append(items, ".. (up)")
+			actions = append(actions, func() (string, bool) { return parentFolder(path), true })
+		}
+		for _, folder := range contents.Folders {
+			folder := folder
+			items = append(items, folder+models.PathSeparator)
+			actions = append(actions, func() (string, bool) { return joinFolder(path, folder), true })
+		}
+		for _, secret := range contents.Secrets {
+			secret := secret
+			items = append(items, fmt.Sprintf("%s (%s)", baseName(secret.Name), models.SecretType(secret.Type)))
+			actions = append(actions, func() (string, bool) {
+				showSecret(ctx, c, models.SecretType(secret.Type), secret.Name)
+				return path, true
+			})
+		}
+
+		items = append(items, "Create folder here")
+		actions = append(actions, func() (string, bool) {
+			createFolder(ctx, c.folders, path)
+			return path, true
+		})
+		if path != "" {
+			items = append(items, "Rename this folder", "Move this folder", "Delete this folder")
+			actions = append(actions,
+				func() (string, bool) { return renameFolder(ctx, c.folders, path), true },
+				func() (string, bool) { return moveFolder(ctx, c.folders, path), true },
+				func() (string, bool) { return deleteFolder(ctx, c.folders, path), true },
+			)
+		}
+		items = append(items, "Back to menu")
+		actions = append(actions, func() (string, bool) { return path, false })
+
+		prompt := promptui.Select{
+			Label: "/" + path,
+			Items: items,
+			Size:  15,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil {
+			log.Fatal().Err(err).Msg("browse folders prompt failed")
+		}
+
+		var stay bool
+		path, stay = actions[idx]()
+		if !stay {
+			return
+		}
+	}
+}
+
+func createFolder(ctx context.Context, client pb.FoldersClient, parent string) {
+	name := getValueFromUser("Enter folder name")
+	folder, err := client.CreateFolder(ctx, &pb.FolderRequest{Path: joinFolder(parent, name)})
+	if err != nil {
+		fmt.Println("Cant create folder!")
+		log.Error().Err(err).Msg("cant create folder on server")
+		return
+	}
+	fmt.Printf("Folder %s created!\n", folder.Path)
+}
+
+// renameFolder returns new path of the folder or old one if folder was not renamed.
+func renameFolder(ctx context.Context, client pb.FoldersClient, path string) string {
+	folder, err := client.RenameFolder(ctx, &pb.RenameFolderRequest{
+		Path:    path,
+		NewName: getValueFromUserWithDefault("Enter new folder name", baseName(path)),
+	})
+	if err != nil {
+		fmt.Println("Cant rename folder!")
+		log.Error().Err(err).Msg("cant rename folder on server")
+		return path
+	}
+	return folder.Path
+}
+
+// moveFolder returns new path of the folder or old one if folder was not moved.
+func moveFolder(ctx context.Context, client pb.FoldersClient, path string) string {
+	folder, err := client.MoveFolder(ctx, &pb.MoveFolderRequest{
+		Path:      path,
+		NewParent: getValueFromUser("Enter path of new parent folder (empty for root)"),
+	})
+	if err != nil {
+		fmt.Println("Cant move folder!")
+		log.Error().Err(err).Msg("cant move folder on server")
+		return path
+	}
+	return folder.Path
+}
+
+// deleteFolder returns parent of the folder or the folder itself if it was not deleted.
+func deleteFolder(ctx context.Context, client pb.FoldersClient, path string) string {
+	if !confirm("Delete folder " + path) {
+		return path
+	}
+
+	_, err := client.DeleteFolder(ctx, &pb.FolderRequest{Path: path})
+	if err != nil {
+		fmt.Println("Cant delete folder! Only folders without secrets can be deleted")
+		log.Error().Err(err).Msg("cant delete folder on server")
+		return path
+	}
+	return parentFolder(path)
+}
+
+func joinFolder(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + models.PathSeparator + name
+}
+
+func parentFolder(path string) string {
+	i := strings.LastIndex(path, models.PathSeparator)
+	if i < 0 {
+		return ""
+	}
+	return path[:i]
+}
+
+func baseName(path string) string {
+	return path[strings.LastIndex(path, models.PathSeparator)+1:]
+}
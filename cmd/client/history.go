@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+func showHistory(ctx context.Context, client pb.SecretsClient) {
+	secretType := getSecretType()
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	resp, err := client.ListRevisions(ctx, &pb.ListRevisionsRequest{
+		Name: secretName,
+		Type: pb.SecretType(secretType),
+	})
+	if err != nil {
+		fmt.Println("Cant get history of your secret!")
+		log.Fatal().Err(err).Msg("cant list revisions from server")
+	}
+	if len(resp.Revisions) == 0 {
+		fmt.Println("This secret has never been changed")
+		return
+	}
+
+	items := make([]string, 0, len(resp.Revisions))
+	for _, revision := range resp.Revisions {
+		items = append(items, fmt.Sprintf(
+			"version %d, saved at %s",
+			revision.Version,
+			revision.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"),
+		))
+	}
+
+	selectRevision := promptui.Select{
+		Label: "Select version",
+		Items: items,
+	}
+	idx, _, err := selectRevision.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose revision prompt failed")
+	}
+
+	req := &pb.RevisionRequest{
+		Name:    secretName,
+		Type:    pb.SecretType(secretType),
+		Version: resp.Revisions[idx].Version,
+	}
+	revision, err := client.GetRevision(ctx, req)
+	if err != nil {
+		fmt.Println("Cant get this version of your secret!")
+		log.Fatal().Err(err).Msg("cant get revision from server")
+	}
+	printRevision(revision)
+
+	confirm := promptui.Prompt{
+		Label:     "Restore this version",
+		IsConfirm: true,
+	}
+	if _, err = confirm.Run(); err != nil {
+		return
+	}
+
+	_, err = client.RestoreRevision(ctx, req)
+	if err != nil {
+		fmt.Println("Cant restore this version of your secret!")
+		log.Fatal().Err(err).Msg("cant restore revision on server")
+	}
+	fmt.Println("Version restored!")
+}
+
+func printRevision(revision *pb.RevisionResponse) {
+	switch secret := revision.Secret.(type) {
+	case *pb.RevisionResponse_Password:
+		fmt.Printf("Login: %s\n", secret.Password.Login)
+		fmt.Printf("Password: %s\n", secret.Password.Password)
+	case *pb.RevisionResponse_Card:
+		fmt.Printf("Number: %s\n", secret.Card.Number)
+		fmt.Printf("Holder name: %s\n", secret.Card.HolderName)
+		fmt.Printf("Date: %s\n", secret.Card.Date)
+	case *pb.RevisionResponse_Text:
+		fmt.Printf("Text: %s\n", secret.Text.Text)
+	}
+}
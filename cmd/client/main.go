@@ -14,21 +14,30 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"os"
+	"strings"
 )
 
 func main() {
 	ctx := context.Background()
 	enableTLS := os.Getenv("ENABLE_TLS") == "true"
 	address := os.Getenv("SERVER_ADDRESS")
+	// in zero-knowledge mode secrets are encrypted with key derived from master password before they are sent
+	zeroKnowledge := os.Getenv("ZERO_KNOWLEDGE") == "true"
 
 	address = "127.0.0.1:9000"
 	enableTLS = false
 
-	authClientConn, err := NewGRPCConn(ctx, address, enableTLS, "")
+	authClientConn, err := NewGRPCConn(ctx, address, enableTLS, "", nil)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cant init client conn for auth")
 	}
 
+	// share links are redeemed without an account: client redeem <token>
+	if len(os.Args) == 3 && os.Args[1] == "redeem" {
+		redeemShareLink(ctx, pb.NewSecretsClient(authClientConn), os.Args[2])
+		return
+	}
+
 	authStep, err := steps.NewAuthStep(authClientConn)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cant init auth step")
@@ -45,82 +54,227 @@ func main() {
 		log.Fatal().Err(err).Msg("choose action prompt failed")
 	}
 
-	authorizedClienConn, err := NewGRPCConn(ctx, address, enableTLS, accessToken)
+	vault := proto.NewClientVaultInterceptor()
+	authorizedClienConn, err := NewGRPCConn(ctx, address, enableTLS, accessToken, vault)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cant init client conn")
 	}
 
-	client := pb.NewSecretsClient(authorizedClienConn)
+	if zeroKnowledge {
+		unlockVault(ctx, pb.NewAuthClient(authorizedClienConn), vault)
+	}
+
+	c := clients{
+		secrets:       pb.NewSecretsClient(authorizedClienConn),
+		templates:     pb.NewTemplatesClient(authorizedClienConn),
+		passwords:     pb.NewPasswordsClient(authorizedClienConn),
+		notifications: pb.NewNotificationsClient(authorizedClienConn),
+		folders:       pb.NewFoldersClient(authorizedClienConn),
+		organizations: pb.NewOrganizationsClient(authorizedClienConn),
+		emergency:     pb.NewEmergencyAccessClient(authorizedClienConn),
+		audit:         pb.NewAuditClient(authorizedClienConn),
+		zeroKnowledge: zeroKnowledge,
+	}
+	showNotifications(ctx, c.notifications)
+	chooseAction(ctx, c)
+}
 
-	chooseAction(ctx, client)
+// clients are clients of all services that are used after authentication.
+type clients struct {
+	secrets       pb.SecretsClient
+	templates     pb.TemplatesClient
+	passwords     pb.PasswordsClient
+	notifications pb.NotificationsClient
+	folders       pb.FoldersClient
+	organizations pb.OrganizationsClient
+	emergency     pb.EmergencyAccessClient
+	audit         pb.AuditClient
+	// zeroKnowledge is set when secrets are encrypted by the client
+	zeroKnowledge bool
 }
 
-func chooseAction(ctx context.Context, client pb.SecretsClient) {
+func chooseAction(ctx context.Context, c clients) {
+	client := c.secrets
 	prompt := promptui.Select{
 		Label: "What would you like to do?",
-		Items: []string{"Get secret", "Add secret"},
+		Items: []string{"Get secret", "Add secret", "Update secret", "Secret history", "Search secrets", "Edit tags", "Delete secret", "Trash", "Templates", "Password policy", "Security report", "Expiring secrets", "Find by URL", "Browse folders", "Share secret", "Shared with me", "Switch vault", "Organizations", "Create share link", "Redeem share link", "Emergency access", "Audit log", "Vault key"},
 	}
 	idx, _, err := prompt.Run()
 	if err != nil {
 		log.Fatal().Err(err).Msg("choose action prompt failed")
 	}
 	if idx == 0 {
-		getSecret(ctx, client)
+		getSecret(ctx, c)
 	}
 	if idx == 1 {
-		addSecret(ctx, client)
+		addSecret(ctx, c)
+	}
+	if idx == 2 {
+		updateSecret(ctx, c)
+	}
+	if idx == 3 {
+		showHistory(ctx, client)
+	}
+	if idx == 4 {
+		searchSecrets(ctx, client)
+	}
+	if idx == 5 {
+		editTags(ctx, client)
+	}
+	if idx == 6 {
+		deleteSecret(ctx, client)
+	}
+	if idx == 7 {
+		manageTrash(ctx, client)
+	}
+	if idx == 8 {
+		manageTemplates(ctx, c.templates)
+	}
+	if idx == 9 {
+		managePasswordPolicy(ctx, c.passwords)
+	}
+	if idx == 10 {
+		showSecurityReport(ctx, client)
+	}
+	if idx == 11 {
+		showExpiring(ctx, client)
+	}
+	if idx == 12 {
+		findByURL(ctx, client)
+	}
+	if idx == 13 {
+		browseFolders(ctx, c)
+	}
+	if idx == 14 {
+		manageShares(ctx, client)
+	}
+	if idx == 15 {
+		showSharedWithMe(ctx, client)
+	}
+	if idx == 16 {
+		ctx = switchVault(ctx, c)
+	}
+	if idx == 17 {
+		manageOrganizations(ctx, c.organizations)
+	}
+	if idx == 18 {
+		createShareLink(ctx, client)
+	}
+	if idx == 19 {
+		redeemShareLink(ctx, client, getValueFromUser("Enter token"))
 	}
-	chooseAction(ctx, client)
+	if idx == 20 {
+		manageEmergencyAccess(ctx, c.emergency)
+	}
+	if idx == 21 {
+		showAuditLog(ctx, c.audit)
+	}
+	if idx == 22 {
+		manageVaultKey(ctx, client)
+	}
+	chooseAction(ctx, c)
 }
 
-func getSecret(ctx context.Context, client pb.SecretsClient) {
+func getSecret(ctx context.Context, c clients) {
 	secretType := getSecretType()
-	secretName := getSecretName()
+	secretName, ok := pickSecretName(ctx, c.secrets, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
 
-	req := &pb.GetSecretRequest{Name: secretName}
+	showSecret(ctx, c, secretType, secretName)
+}
 
-	switch secretType {
-	case models.SecretTypePassword:
-		resp, err := client.GetPassword(ctx, req)
+func showSecret(ctx context.Context, c clients, secretType models.SecretType, secretName string) {
+	client := c.secrets
+	if secretType == models.SecretTypeBinary {
+		downloadFile(ctx, client, &pb.GetSecretRequest{Name: secretName})
+		return
+	}
+
+	if secretType == models.SecretTypeTOTP {
+		showTOTPCode(ctx, client, secretName)
+		return
+	}
+
+	if secretType == models.SecretTypeSSHKey {
+		showSSHKey(ctx, client, secretName)
+		return
+	}
+
+	resp, err := client.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: secretName,
+		Type: pb.SecretType(secretType),
+	})
+	if err != nil {
+		fmt.Println("Cant get your secret!")
+		log.Fatal().Err(err).Msg("cant get secret from server")
+	}
+
+	if custom := resp.Payload.GetCustom(); custom != nil {
+		template, err := c.templates.GetTemplate(ctx, &pb.TemplateIDRequest{Id: custom.TemplateId})
 		if err != nil {
-			fmt.Println("Cant get your secret!")
-			log.Fatal().Err(err).Msg("cant get password from server")
+			fmt.Println("Cant get template of your secret!")
+			log.Fatal().Err(err).Msg("cant get template from server")
 		}
-		fmt.Printf("Login: %s\n", resp.Login)
-		fmt.Printf("Password: %s\n", resp.Password)
+		printCustomFields(custom, template)
 		return
-	case models.SecretTypeCard:
-		resp, err := client.GetCard(ctx, req)
+	}
+
+	printSecretPayload(resp.Payload)
+
+	if password := resp.Payload.GetPassword().GetPassword(); password != "" {
+		printBreachCheck(checkBreached(ctx, c.passwords, password))
+	}
+
+	if secretType == models.SecretTypeCard && confirm("Reveal full number and CCV") {
+		card, err := client.RevealCard(ctx, &pb.GetSecretRequest{Name: secretName})
 		if err != nil {
-			fmt.Println("Cant get your secret!")
-			log.Fatal().Err(err).Msg("cant get card from server")
+			fmt.Println("Cant reveal your card!")
+			log.Fatal().Err(err).Msg("cant reveal card on server")
 		}
-		fmt.Printf("Number: %s\n", resp.Number)
-		fmt.Printf("Holder name: %s\n", resp.HolderName)
-		fmt.Printf("Date: %s\n", resp.Date)
-		fmt.Printf("CCV: %s\n", resp.Ccv)
-	case models.SecretTypeText:
-		resp, err := client.GetText(ctx, req)
-		if err != nil {
-			fmt.Println("Cant get your secret!")
-			log.Fatal().Err(err).Msg("cant get text from server")
+		fmt.Printf("Number: %s\n", card.Number)
+		fmt.Printf("CCV: %s\n", card.Ccv)
+	}
+}
+
+func printSecretPayload(payload *pb.SecretPayload) {
+	switch data := payload.GetPayload().(type) {
+	case *pb.SecretPayload_Password:
+		fmt.Printf("Login: %s\n", data.Password.Login)
+		fmt.Printf("Password: %s\n", data.Password.Password)
+		if len(data.Password.Urls) > 0 {
+			fmt.Printf("Websites: %s\n", formatURLs(data.Password.Urls))
 		}
-		fmt.Printf("Text: %s\n", resp.Text)
+	case *pb.SecretPayload_Card:
+		fmt.Printf("Number: %s\n", data.Card.Number)
+		fmt.Printf("Brand: %s\n", data.Card.Brand)
+		fmt.Printf("Holder name: %s\n", data.Card.HolderName)
+		fmt.Printf("Date: %s\n", data.Card.Date)
+	case *pb.SecretPayload_Text:
+		fmt.Printf("Text: %s\n", data.Text.Text)
+	case *pb.SecretPayload_File:
+		fmt.Printf("File: %s (%d bytes)\n", data.File.FileName, data.File.Size)
+	case *pb.SecretPayload_Encrypted:
+		fmt.Println("Secret is encrypted by the client of its owner")
 	}
-	fmt.Println(secretType, secretName)
 }
 
-func addSecret(ctx context.Context, client pb.SecretsClient) {
+func addSecret(ctx context.Context, c clients) {
+	client := c.secrets
 	secretType := getSecretType()
 	secretName := getSecretName()
 
 	switch secretType {
 	case models.SecretTypePassword:
 		req := &pb.SavePasswordRequest{
-			Name:     secretName,
-			Login:    getValueFromUser("Enter login"),
-			Password: getValueFromUser("Enter password"),
+			Name:  secretName,
+			Login: getValueFromUser("Enter login"),
 		}
+		req.Password = getPasswordFromUser(ctx, c, generatePasswordForUser(ctx, c), req.Login, secretName)
+		req.Urls = getURLsFromUser(nil)
+		req.Attributes = getAttributesFromUser()
 		_, err := client.SavePassword(ctx, req)
 		if err != nil {
 			fmt.Println("Cant save your secret!")
@@ -133,9 +287,10 @@ func addSecret(ctx context.Context, client pb.SecretsClient) {
 			CardName:   secretName,
 			Number:     getValueFromUser("Enter card number"),
 			HolderName: getValueFromUser("Enter card holder name"),
-			Date:       getValueFromUser("Enter card date"),
+			Date:       getValueFromUser("Enter card expiry date (MM/YY)"),
 			Ccv:        getValueFromUser("Enter card ccv"),
 		}
+		req.Attributes = getAttributesFromUser()
 		_, err := client.SaveCard(ctx, req)
 		if err != nil {
 			fmt.Println("Cant save your secret!")
@@ -148,6 +303,7 @@ func addSecret(ctx context.Context, client pb.SecretsClient) {
 			Name: secretName,
 			Text: getValueFromUser("Enter text"),
 		}
+		req.Attributes = getAttributesFromUser()
 		_, err := client.SaveText(ctx, req)
 		if err != nil {
 			fmt.Println("Cant save your secret!")
@@ -155,9 +311,82 @@ func addSecret(ctx context.Context, client pb.SecretsClient) {
 		}
 		fmt.Println("Text Saved!")
 		return
+	case models.SecretTypeBinary:
+		uploadFile(ctx, client, secretName)
+		return
+	case models.SecretTypeTOTP:
+		addTOTP(ctx, client, secretName)
+		return
+	case models.SecretTypeSSHKey:
+		addSSHKey(ctx, client, secretName)
+		return
+	case models.SecretTypeCustom:
+		template, ok := pickTemplate(ctx, c.templates)
+		if !ok {
+			fmt.Println("You have no templates. Create one first")
+			return
+		}
+		req := &pb.PutSecretRequest{
+			Name: secretName,
+			Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Custom{Custom: &pb.CustomData{
+				TemplateId: template.Id,
+				Fields:     getCustomFieldsFromUser(template, nil),
+			}}},
+		}
+		req.Attributes = getAttributesFromUser()
+		_, err := client.PutSecret(ctx, req)
+		if err != nil {
+			fmt.Println("Cant save your secret!")
+			log.Fatal().Err(err).Msg("cant save custom secret on server")
+		}
+		fmt.Println("Secret Saved!")
+		return
 	}
 }
 
+func listSecretNames(ctx context.Context, client pb.SecretsClient, secretType models.SecretType) []string {
+	var names []string
+
+	req := &pb.ListSecretsRequest{Type: pb.SecretType(secretType)}
+	for {
+		resp, err := client.ListSecrets(ctx, req)
+		if err != nil {
+			fmt.Println("Cant list your secrets!")
+			log.Fatal().Err(err).Msg("cant list secrets from server")
+		}
+
+		for _, secret := range resp.Secrets {
+			names = append(names, secret.Name)
+		}
+
+		if resp.NextCursor == 0 {
+			return names
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+func pickSecretName(ctx context.Context, client pb.SecretsClient, secretType models.SecretType) (string, bool) {
+	names := listSecretNames(ctx, client, secretType)
+	if len(names) == 0 {
+		return "", false
+	}
+
+	prompt := promptui.Select{
+		Label: "Select secret",
+		Items: names,
+		Searcher: func(input string, index int) bool {
+			return strings.Contains(strings.ToLower(names[index]), strings.ToLower(input))
+		},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose secret prompt failed")
+	}
+
+	return names[idx], true
+}
+
 func getValueFromUser(label string) string {
 	prompt := promptui.Prompt{
 		Label: label,
@@ -171,6 +400,21 @@ func getValueFromUser(label string) string {
 	return value
 }
 
+func getValueFromUserWithDefault(label string, defaultValue string) string {
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   defaultValue,
+		AllowEdit: true,
+	}
+
+	value, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant get value from user")
+	}
+
+	return value
+}
+
 func getSecretName() string {
 	prompt := promptui.Prompt{
 		Label: "Enter secret name",
@@ -189,6 +433,10 @@ func getSecretType() models.SecretType {
 		models.SecretTypePassword,
 		models.SecretTypeCard,
 		models.SecretTypeText,
+		models.SecretTypeBinary,
+		models.SecretTypeCustom,
+		models.SecretTypeTOTP,
+		models.SecretTypeSSHKey,
 	}
 	prompt := promptui.Select{
 		Label: "Select type of secret",
@@ -202,7 +450,14 @@ func getSecretType() models.SecretType {
 	return secretTypes[idx]
 }
 
-func NewGRPCConn(ctx context.Context, address string, enableTLS bool, accessToken string) (*grpc.ClientConn, error) {
+// NewGRPCConn returns connection to the server. Authorized connection also encrypts secrets with vault if it is not nil.
+func NewGRPCConn(
+	ctx context.Context,
+	address string,
+	enableTLS bool,
+	accessToken string,
+	vault *proto.ClientVaultInterceptor,
+) (*grpc.ClientConn, error) {
 	transportOption := grpc.WithTransportCredentials(insecure.NewCredentials())
 
 	if enableTLS {
@@ -216,12 +471,18 @@ func NewGRPCConn(ctx context.Context, address string, enableTLS bool, accessToke
 
 	if accessToken != "" {
 		clientAuthInterceptor := proto.NewClientAuthInterceptor(accessToken)
+		unaryInterceptors := []grpc.UnaryClientInterceptor{clientAuthInterceptor.Unary()}
+		streamInterceptors := []grpc.StreamClientInterceptor{clientAuthInterceptor.Stream()}
+		if vault != nil {
+			unaryInterceptors = append(unaryInterceptors, vault.Unary())
+			streamInterceptors = append(streamInterceptors, vault.Stream())
+		}
 		return getClientConn(
 			ctx,
 			address,
 			transportOption,
-			grpc.WithUnaryInterceptor(clientAuthInterceptor.Unary()),
-			grpc.WithUnaryInterceptor(clientAuthInterceptor.Unary()),
+			grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+			grpc.WithChainStreamInterceptor(streamInterceptors...),
 		)
 	}
 	return getClientConn(ctx, address, transportOption)
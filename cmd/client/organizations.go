@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/proto"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+// switchVault returns context for requests to personal vault, to collection of organization
+// or to vault of another user with emergency access chosen by user.
+func switchVault(ctx context.Context, c clients) context.Context {
+	client := c.organizations
+	organizations, err := client.ListOrganizations(ctx, &pb.ListOrganizationsRequest{})
+	if err != nil {
+		fmt.Println("Cant get your organizations!")
+		log.Error().Err(err).Msg("cant list organizations from server")
+		return ctx
+	}
+
+	items := []string{"Personal vault"}
+	collectionIDs := []int64{0}
+	for _, organization := range organizations.Organizations {
+		collections, err := client.ListCollections(ctx, &pb.OrganizationRequest{OrganizationId: organization.Id})
+		if err != nil {
+			log.Error().Err(err).Msg("cant list collections from server")
+			continue
+		}
+		for _, collection := range collections.Collections {
+			items = append(items, fmt.Sprintf("%s / %s", organization.Name, collection.Name))
+			collectionIDs = append(collectionIDs, collection.Id)
+		}
+	}
+
+	collectionsCount := len(items)
+	var ownerIDs []int
+	grantors, err := c.emergency.ListGrantors(ctx, &pb.ListContactsRequest{})
+	if err != nil {
+		log.Error().Err(err).Msg("cant list emergency grantors from server")
+	}
+	for _, grantor := range grantors.GetContacts() {
+		if grantor.AccessGranted {
+			items = append(items, fmt.Sprintf("Emergency: %s (read-only)", grantor.Owner))
+			ownerIDs = append(ownerIDs, int(grantor.OwnerId))
+		}
+	}
+
+	prompt := promptui.Select{
+		Label: "Choose vault",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose vault prompt failed")
+	}
+
+	fmt.Printf("Switched to %s\n", items[idx])
+	if idx >= collectionsCount {
+		return proto.WithEmergencyOwner(ctx, ownerIDs[idx-collectionsCount])
+	}
+	return proto.WithCollection(ctx, collectionIDs[idx])
+}
+
+func manageOrganizations(ctx context.Context, client pb.OrganizationsClient) {
+	resp, err := client.ListOrganizations(ctx, &pb.ListOrganizationsRequest{})
+	if err != nil {
+		fmt.Println("Cant get your organizations!")
+		log.Fatal().Err(err).Msg("cant list organizations from server")
+	}
+
+	items := []string{"Create organization"}
+	for _, organization := range resp.Organizations {
+		items = append(items, fmt.Sprintf("%s (%s)", organization.Name, models.OrganizationRole(organization.Role)))
+	}
+
+	prompt := promptui.Select{
+		Label: "Choose organization",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose organization prompt failed")
+	}
+
+	if idx == 0 {
+		organization, err := client.CreateOrganization(ctx, &pb.CreateOrganizationRequest{
+			Name: getValueFromUser("Enter organization name"),
+		})
+		if err != nil {
+			fmt.Println("Cant create organization!")
+			log.Error().Err(err).Msg("cant create organization on server")
+			return
+		}
+		fmt.Printf("Organization %s created! Create a collection to store its secrets\n", organization.Name)
+		return
+	}
+
+	manageOrganization(ctx, client, resp.Organizations[idx-1])
+}
+
+func manageOrganization(ctx context.Context, client pb.OrganizationsClient, organization *pb.Organization) {
+	prompt := promptui.Select{
+		Label: organization.Name,
+		Items: []string{"List members", "Add member", "Change member role", "Remove member", "List collections", "Create collection", "Back"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("manage organization prompt failed")
+	}
+
+	switch idx {
+	case 0:
+		listMembers(ctx, client, organization.Id)
+	case 1:
+		_, err = client.AddMember(ctx, &pb.MemberRequest{
+			OrganizationId: organization.Id,
+			Username:       getValueFromUser("Enter username"),
+			Role:           getOrganizationRole(),
+		})
+		printMemberResult(err, "Member added!", "Cant add member!")
+	case 2:
+		_, err = client.UpdateMemberRole(ctx, &pb.MemberRequest{
+			OrganizationId: organization.Id,
+			Username:       getValueFromUser("Enter username"),
+			Role:           getOrganizationRole(),
+		})
+		printMemberResult(err, "Role changed!", "Cant change role!")
+	case 3:
+		_, err = client.RemoveMember(ctx, &pb.MemberRequest{
+			OrganizationId: organization.Id,
+			Username:       getValueFromUser("Enter username"),
+		})
+		printMemberResult(err, "Member removed!", "Cant remove member!")
+	case 4:
+		listCollections(ctx, client, organization.Id)
+	case 5:
+		collection, err := client.CreateCollection(ctx, &pb.CreateCollectionRequest{
+			OrganizationId: organization.Id,
+			Name:           getValueFromUser("Enter collection name"),
+		})
+		if err != nil {
+			fmt.Println("Cant create collection!")
+			log.Error().Err(err).Msg("cant create collection on server")
+			return
+		}
+		fmt.Printf("Collection %s created! Switch vault to use it\n", collection.Name)
+	}
+}
+
+func listMembers(ctx context.Context, client pb.OrganizationsClient, organizationID int64) {
+	resp, err := client.ListMembers(ctx, &pb.OrganizationRequest{OrganizationId: organizationID})
+	if err != nil {
+		fmt.Println("Cant get members!")
+		log.Error().Err(err).Msg("cant list members from server")
+		return
+	}
+
+	for _, member := range resp.Members {
+		fmt.Printf("%s: %s\n", member.Username, models.OrganizationRole(member.Role))
+	}
+}
+
+func listCollections(ctx context.Context, client pb.OrganizationsClient, organizationID int64) {
+	resp, err := client.ListCollections(ctx, &pb.OrganizationRequest{OrganizationId: organizationID})
+	if err != nil {
+		fmt.Println("Cant get collections!")
+		log.Error().Err(err).Msg("cant list collections from server")
+		return
+	}
+	if len(resp.Collections) == 0 {
+		fmt.Println("Organization has no collections")
+	}
+
+	for _, collection := range resp.Collections {
+		fmt.Println(collection.Name)
+	}
+}
+
+func printMemberResult(err error, success string, failure string) {
+	if err != nil {
+		fmt.Println(failure)
+		log.Error().Err(err).Msg("cant manage members on server")
+		return
+	}
+	fmt.Println(success)
+}
+
+func getOrganizationRole() pb.OrganizationRole {
+	roles := []models.OrganizationRole{models.RoleReadOnly, models.RoleMember, models.RoleAdmin, models.RoleOwner}
+	items := make([]string, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, role.String())
+	}
+
+	prompt := promptui.Select{
+		Label: "Choose role",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose role prompt failed")
+	}
+
+	return pb.OrganizationRole(roles[idx])
+}
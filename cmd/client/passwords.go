@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // corpus of breached passwords is keyed by sha-1
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// breachHashPrefixLength is length of prefix of password hash that is sent to check password for breaches.
+const breachHashPrefixLength = 5
+
+//nolint:gochecknoglobals
+var passwordScoreNames = []string{"very weak", "weak", "fair", "good", "strong"}
+
+// getPasswordFromUser asks password and shows its strength until user accepts it.
+// In zero-knowledge mode strength is estimated by the client, so password is not sent to the server.
+func getPasswordFromUser(ctx context.Context, c clients, defaultPassword string, userInputs ...string) string {
+	for {
+		password := getValueFromUserWithDefault("Enter password", defaultPassword)
+
+		strength, err := estimatePasswordStrength(ctx, c, password, userInputs)
+		if err != nil {
+			fmt.Println("Cant check strength of your password!")
+			log.Fatal().Err(err).Msg("cant estimate password strength on server")
+		}
+
+		printPasswordStrength(strength)
+		printBreachCheck(checkBreached(ctx, c.passwords, password))
+		if confirm("Use this password") {
+			return password
+		}
+		defaultPassword = password
+	}
+}
+
+func estimatePasswordStrength(ctx context.Context, c clients, password string, userInputs []string) (*pb.PasswordStrength, error) {
+	if !c.zeroKnowledge {
+		return c.passwords.EstimatePasswordStrength(ctx, &pb.EstimatePasswordStrengthRequest{
+			Password:   password,
+			UserInputs: userInputs,
+		})
+	}
+
+	strength := services.EstimatePasswordStrength(password, userInputs...)
+	return &pb.PasswordStrength{
+		Score:    int32(strength.Score),
+		Entropy:  strength.Entropy,
+		Warnings: strength.Warnings,
+	}, nil
+}
+
+// generatePasswordForUser offers to generate password or passphrase.
+// Returns empty string if user wants to enter password manually.
+// In zero-knowledge mode password is generated by the client, so the server doesn't know it.
+func generatePasswordForUser(ctx context.Context, c clients) string {
+	prompt := promptui.Select{
+		Label: "How do you want to set the password?",
+		Items: []string{"Enter manually", "Generate password", "Generate passphrase"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose password source prompt failed")
+	}
+
+	req := &pb.GeneratePasswordRequest{}
+	switch idx {
+	case 1:
+		req.Policy = &pb.GeneratePasswordRequest_Password{Password: &pb.GenerationPolicy{
+			Length:           getNumberFromUser("Enter password length", 20),
+			Lower:            true,
+			Upper:            true,
+			Digits:           true,
+			Symbols:          confirm("Include symbols"),
+			ExcludeAmbiguous: confirm("Exclude ambiguous characters like l, 1, O and 0"),
+		}}
+	case 2:
+		req.Policy = &pb.GeneratePasswordRequest_Passphrase{Passphrase: &pb.PassphrasePolicy{
+			Words:         getNumberFromUser("Enter number of words", 6),
+			Divider:       getValueFromUserWithDefault("Enter words divider", "-"),
+			Capitalize:    confirm("Capitalize words"),
+			IncludeNumber: confirm("Include number"),
+		}}
+	default:
+		return ""
+	}
+
+	password, err := generatePassword(ctx, c, req)
+	if err != nil {
+		fmt.Println("Cant generate password!")
+		log.Error().Err(err).Msg("cant generate password")
+		return ""
+	}
+
+	return password
+}
+
+func generatePassword(ctx context.Context, c clients, req *pb.GeneratePasswordRequest) (string, error) {
+	if !c.zeroKnowledge {
+		generated, err := c.passwords.GeneratePassword(ctx, req)
+		return generated.GetPassword(), err
+	}
+
+	generator := services.NewPasswordGenerator(&services.TrulyRandomGenerator{})
+	if passphrase := req.GetPassphrase(); passphrase != nil {
+		return generator.GeneratePassphrase(services.PassphrasePolicy{
+			Words:         int(passphrase.GetWords()),
+			Divider:       passphrase.GetDivider(),
+			Capitalize:    passphrase.GetCapitalize(),
+			IncludeNumber: passphrase.GetIncludeNumber(),
+		})
+	}
+
+	policy := req.GetPassword()
+	return generator.GeneratePassword(services.GenerationPolicy{
+		Length:           int(policy.GetLength()),
+		Lower:            policy.GetLower(),
+		Upper:            policy.GetUpper(),
+		Digits:           policy.GetDigits(),
+		Symbols:          policy.GetSymbols(),
+		ExcludeAmbiguous: policy.GetExcludeAmbiguous(),
+	})
+}
+
+func getNumberFromUser(label string, defaultValue int) int32 {
+	for {
+		number, err := strconv.Atoi(getValueFromUserWithDefault(label, strconv.Itoa(defaultValue)))
+		if err == nil {
+			return int32(number)
+		}
+		fmt.Println("Value must be a number")
+	}
+}
+
+// checkBreached looks up password in breached hashes that start with the same prefix of its sha-1 hash,
+// so neither password nor its full hash is sent to the server.
+// Returns nil if password cant be checked, for example when server has no corpus of breached passwords.
+func checkBreached(ctx context.Context, client pb.PasswordsClient, password string) *pb.BreachCheck {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // corpus of breached passwords is keyed by sha-1
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	breaches, err := client.CheckBreachedRange(ctx, &pb.BreachRangeRequest{Prefix: hash[:breachHashPrefixLength]})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("cant check breached password on server")
+		return nil
+	}
+
+	for _, breached := range breaches.Hashes {
+		if strings.EqualFold(breached.Suffix, hash[breachHashPrefixLength:]) {
+			return &pb.BreachCheck{Breached: true, Count: breached.Count}
+		}
+	}
+
+	return &pb.BreachCheck{}
+}
+
+func printBreachCheck(breach *pb.BreachCheck) {
+	if breach == nil {
+		return
+	}
+	if breach.Breached {
+		fmt.Printf("Warning! This password was seen %d times in data breaches\n", breach.Count)
+		return
+	}
+	fmt.Println("This password was not found in known data breaches")
+}
+
+func printPasswordStrength(strength *pb.PasswordStrength) {
+	fmt.Printf("Password strength: %s (%d/4, ~%.0f bits)\n", scoreName(strength.Score), strength.Score, strength.Entropy)
+	for _, warning := range strength.Warnings {
+		fmt.Printf("  - %s\n", warning)
+	}
+}
+
+func managePasswordPolicy(ctx context.Context, client pb.PasswordsClient) {
+	policy, err := client.GetPasswordPolicy(ctx, &pb.GetPasswordPolicyRequest{})
+	if err != nil {
+		fmt.Println("Cant get your password policy!")
+		log.Fatal().Err(err).Msg("cant get password policy from server")
+	}
+	fmt.Printf("Minimal password strength: %s (%d/4)\n", scoreName(policy.MinScore), policy.MinScore)
+
+	input := getValueFromUserWithDefault("Enter new minimal score from 0 to 4", strconv.Itoa(int(policy.MinScore)))
+	minScore, err := strconv.Atoi(input)
+	if err != nil {
+		fmt.Println("Score must be a number")
+		return
+	}
+
+	_, err = client.SetPasswordPolicy(ctx, &pb.PasswordPolicy{MinScore: int32(minScore)})
+	if err != nil {
+		fmt.Println("Cant set your password policy!")
+		log.Error().Err(err).Msg("cant set password policy on server")
+		return
+	}
+	fmt.Println("Password policy saved!")
+}
+
+func scoreName(score int32) string {
+	if score < 0 || int(score) >= len(passwordScoreNames) {
+		return "unknown"
+	}
+	return passwordScoreNames[score]
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+func showSecurityReport(ctx context.Context, client pb.SecretsClient) {
+	report, err := client.SecurityReport(ctx, &pb.SecurityReportRequest{
+		MaxPasswordAgeDays: getNumberFromUser("Report passwords unchanged for more days than", 180),
+	})
+	if err != nil {
+		fmt.Println("Cant get security report!")
+		log.Fatal().Err(err).Msg("cant get security report from server")
+	}
+
+	if len(report.ReusedPasswords)+len(report.WeakPasswords)+len(report.OldPasswords)+
+		len(report.ExpiredCards)+len(report.ExpiringCards) == 0 {
+		fmt.Println("No problems found!")
+		return
+	}
+
+	if len(report.ReusedPasswords) > 0 {
+		fmt.Println("Reused passwords:")
+		for _, group := range report.ReusedPasswords {
+			fmt.Printf("  - %s\n", strings.Join(group.Names, ", "))
+		}
+	}
+	if len(report.WeakPasswords) > 0 {
+		fmt.Println("Weak passwords:")
+		for _, weak := range report.WeakPasswords {
+			fmt.Printf("  - %s: %s\n", weak.Name, scoreName(weak.Strength.GetScore()))
+		}
+	}
+	if len(report.OldPasswords) > 0 {
+		fmt.Println("Old passwords:")
+		for _, old := range report.OldPasswords {
+			fmt.Printf("  - %s: changed at %s\n", old.Name, old.ChangedAt.AsTime().Local().Format("2006-01-02"))
+		}
+	}
+	printExpiringCards("Expired cards:", report.ExpiredCards)
+	printExpiringCards("Cards expiring soon:", report.ExpiringCards)
+}
+
+func printExpiringCards(title string, cards []*pb.ExpiringCardReport) {
+	if len(cards) == 0 {
+		return
+	}
+
+	fmt.Println(title)
+	for _, card := range cards {
+		// expires_at is the end of expiry month, so print the month itself
+		fmt.Printf("  - %s (**** %s): %s\n", card.Name, card.Last4, card.ExpiresAt.AsTime().AddDate(0, 0, -1).Format("01/06"))
+	}
+}
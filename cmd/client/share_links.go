@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+func createShareLink(ctx context.Context, client pb.SecretsClient) {
+	secretType := getSecretType()
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	link, err := client.CreateShareLink(ctx, &pb.CreateShareLinkRequest{
+		Name:           secretName,
+		Type:           pb.SecretType(secretType),
+		MaxRedemptions: getNumberFromUser("How many times can the link be redeemed", 1),
+		TtlHours:       getNumberFromUser("How many hours is the link valid", 24),
+	})
+	if err != nil {
+		fmt.Println("Cant create share link!")
+		log.Error().Err(err).Msg("cant create share link on server")
+		return
+	}
+
+	fmt.Println("Share link created! Send this token to the recipient, it can't be shown again:")
+	fmt.Println(link.Token)
+	fmt.Printf(
+		"It can be redeemed %d time(s) until %s\n",
+		link.MaxRedemptions,
+		link.ExpiresAt.AsTime().Local().Format(expiryDateLayout),
+	)
+}
+
+// redeemShareLink shows snapshot of the secret from share link. It works without authentication.
+func redeemShareLink(ctx context.Context, client pb.SecretsClient, token string) {
+	resp, err := client.RedeemShareLink(ctx, &pb.RedeemShareLinkRequest{Token: token})
+	if err != nil {
+		fmt.Println("Cant redeem share link! It may be expired or already redeemed")
+		log.Error().Err(err).Msg("cant redeem share link on server")
+		return
+	}
+
+	fmt.Printf("Name: %s\n", resp.Name)
+	printSecretPayload(resp.Payload)
+	fmt.Printf(
+		"Link can be redeemed %d more time(s) until %s\n",
+		resp.RedemptionsLeft,
+		resp.ExpiresAt.AsTime().Local().Format(expiryDateLayout),
+	)
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+// manageShares shows who has access to own secret and lets user share it or revoke access.
+func manageShares(ctx context.Context, client pb.SecretsClient) {
+	secretType := getSecretType()
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	resp, err := client.ListShares(ctx, &pb.ListSharesRequest{Name: secretName, Type: pb.SecretType(secretType)})
+	if err != nil {
+		fmt.Println("Cant get users with access to your secret!")
+		log.Fatal().Err(err).Msg("cant list shares from server")
+	}
+	if len(resp.Shares) == 0 {
+		fmt.Println("Secret is not shared with anyone")
+	}
+	for _, share := range resp.Shares {
+		fmt.Printf(
+			"%s: %s since %s\n",
+			share.Username,
+			models.SharePermission(share.Permission),
+			share.CreatedAt.AsTime().Local().Format(expiryDateLayout),
+		)
+	}
+
+	prompt := promptui.Select{
+		Label: "What would you like to do?",
+		Items: []string{"Share with user", "Revoke access", "Back"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("manage shares prompt failed")
+	}
+
+	switch idx {
+	case 0:
+		_, err = client.ShareSecret(ctx, &pb.ShareSecretRequest{
+			Name:       secretName,
+			Type:       pb.SecretType(secretType),
+			Username:   getValueFromUser("Enter username"),
+			Permission: getSharePermission(),
+		})
+		if err != nil {
+			fmt.Println("Cant share your secret!")
+			log.Error().Err(err).Msg("cant share secret on server")
+			return
+		}
+		fmt.Println("Secret shared!")
+	case 1:
+		_, err = client.RevokeShare(ctx, &pb.RevokeShareRequest{
+			Name:     secretName,
+			Type:     pb.SecretType(secretType),
+			Username: getValueFromUser("Enter username"),
+		})
+		if err != nil {
+			fmt.Println("Cant revoke access!")
+			log.Error().Err(err).Msg("cant revoke share on server")
+			return
+		}
+		fmt.Println("Access revoked!")
+	}
+}
+
+func getSharePermission() pb.SharePermission {
+	prompt := promptui.Select{
+		Label: "Choose permission",
+		Items: []string{models.SharePermissionRead.String(), models.SharePermissionWrite.String()},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose permission prompt failed")
+	}
+
+	return pb.SharePermission(idx + 1)
+}
+
+// showSharedWithMe lets user open secrets that other users shared with user
+// and update them if they are shared with read-write permission.
+func showSharedWithMe(ctx context.Context, client pb.SecretsClient) {
+	resp, err := client.ListSharedWithMe(ctx, &pb.Empty{})
+	if err != nil {
+		fmt.Println("Cant get secrets shared with you!")
+		log.Fatal().Err(err).Msg("cant list shared secrets from server")
+	}
+	if len(resp.Secrets) == 0 {
+		fmt.Println("Nobody shared secrets with you")
+		return
+	}
+
+	items := make([]string, 0, len(resp.Secrets))
+	for _, secret := range resp.Secrets {
+		items = append(items, fmt.Sprintf(
+			"%s (%s) from %s, %s",
+			secret.Metadata.Name,
+			models.SecretType(secret.Metadata.Type),
+			secret.Owner,
+			models.SharePermission(secret.Permission),
+		))
+	}
+
+	prompt := promptui.Select{
+		Label: "Select secret",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose shared secret prompt failed")
+	}
+	shared := resp.Secrets[idx]
+
+	if shared.Metadata.Type == pb.SecretType_SECRET_TYPE_BINARY {
+		downloadFile(ctx, client, &pb.GetSecretRequest{SharedId: shared.Metadata.Id})
+		return
+	}
+
+	secret, err := client.RevealSecret(ctx, &pb.GetSecretRequest{
+		Type:     shared.Metadata.Type,
+		SharedId: shared.Metadata.Id,
+	})
+	if err != nil {
+		fmt.Println("Cant get shared secret!")
+		log.Fatal().Err(err).Msg("cant reveal shared secret on server")
+	}
+	printSecretPayload(secret.Payload)
+
+	if shared.Permission == pb.SharePermission_SHARE_PERMISSION_WRITE {
+		updateSharedSecret(ctx, client, secret)
+	}
+}
+
+// updateSharedSecret lets user change login and password of shared password or shared text.
+func updateSharedSecret(ctx context.Context, client pb.SecretsClient, secret *pb.SecretResponse) {
+	payload := secret.Payload
+	switch data := payload.GetPayload().(type) {
+	case *pb.SecretPayload_Password:
+		if !confirm("Update shared password") {
+			return
+		}
+		data.Password.Login = getValueFromUserWithDefault("Enter login", data.Password.Login)
+		data.Password.Password = getValueFromUserWithDefault("Enter password", data.Password.Password)
+	case *pb.SecretPayload_Text:
+		if !confirm("Update shared text") {
+			return
+		}
+		data.Text.Text = getValueFromUserWithDefault("Enter text", data.Text.Text)
+	default:
+		return
+	}
+
+	resp, err := client.PutSecret(ctx, &pb.PutSecretRequest{
+		Payload:  payload,
+		Version:  secret.Metadata.Version,
+		SharedId: secret.Metadata.Id,
+	})
+	if err != nil {
+		fmt.Println("Cant update shared secret!")
+		log.Error().Err(err).Msg("cant update shared secret on server")
+		return
+	}
+	fmt.Printf("Secret updated to version %d\n", resp.Version)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+func addSSHKey(ctx context.Context, client pb.SecretsClient, secretName string) {
+	prompt := promptui.Select{
+		Label: "How do you want to add the key?",
+		Items: []string{"Generate new key pair", "Import private key from file"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose ssh key source prompt failed")
+	}
+
+	data := &pb.SSHKeyData{}
+	if idx == 0 {
+		data.Comment = getValueFromUser("Enter key comment (optional)")
+		data.PrivateKey, err = services.GenerateSSHKey(getSSHKeyAlgorithm(), data.Comment)
+		if err != nil {
+			fmt.Println("Cant generate key!")
+			log.Fatal().Err(err).Msg("cant generate ssh key")
+		}
+	} else {
+		var privateKey []byte
+		privateKey, err = os.ReadFile(getValueFromUser("Enter path to private key"))
+		if err != nil {
+			fmt.Println("Cant read key file!")
+			log.Error().Err(err).Msg("cant read ssh key file")
+			return
+		}
+		data.PrivateKey = string(privateKey)
+		data.Passphrase = getConcealedValueFromUser("Enter key passphrase (empty if key is not protected)")
+		data.Comment = getValueFromUser("Enter key comment (optional)")
+	}
+
+	_, err = client.PutSecret(ctx, &pb.PutSecretRequest{
+		Name:       secretName,
+		Payload:    &pb.SecretPayload{Payload: &pb.SecretPayload_SshKey{SshKey: data}},
+		Attributes: getAttributesFromUser(),
+	})
+	if err != nil {
+		fmt.Println("Cant save your key!")
+		log.Fatal().Err(err).Msg("cant save ssh key on server")
+	}
+	fmt.Println("SSH key Saved!")
+}
+
+func showSSHKey(ctx context.Context, client pb.SecretsClient, secretName string) {
+	resp, err := client.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: secretName,
+		Type: pb.SecretType_SECRET_TYPE_SSH_KEY,
+	})
+	if err != nil {
+		fmt.Println("Cant get your secret!")
+		log.Fatal().Err(err).Msg("cant get ssh key from server")
+	}
+
+	key := resp.Payload.GetSshKey()
+	fmt.Printf("Type: %s\n", key.KeyType)
+	fmt.Printf("Fingerprint: %s\n", key.Fingerprint)
+	fmt.Printf("Public key: %s\n", key.PublicKey)
+
+	if confirm("Export key to files") {
+		exportSSHKey(key)
+	}
+}
+
+// exportSSHKey writes private key in OpenSSH format and public key next to it,
+// with the same permissions ssh-keygen uses.
+func exportSSHKey(key *pb.SSHKeyData) {
+	privateKey, err := services.ExportOpenSSHPrivateKey(key.PrivateKey, key.Comment)
+	if err != nil {
+		fmt.Println("Cant export your key!")
+		log.Error().Err(err).Msg("cant convert ssh key to openssh format")
+		return
+	}
+
+	path := getValueFromUserWithDefault("Save private key to", "id_"+sshKeyFileSuffix(key.KeyType))
+	err = writeNewFile(path, privateKey, 0o600)
+	if err != nil {
+		fmt.Println("Cant write private key! Maybe file already exists")
+		log.Error().Err(err).Msg("cant write private key")
+		return
+	}
+
+	err = writeNewFile(path+".pub", []byte(key.PublicKey+"\n"), 0o644)
+	if err != nil {
+		fmt.Println("Cant write public key! Maybe file already exists")
+		log.Error().Err(err).Msg("cant write public key")
+		return
+	}
+
+	fmt.Printf("Key saved to %s and %s.pub\n", path, path)
+}
+
+// writeNewFile writes data to file that must not exist yet.
+func writeNewFile(path string, data []byte, perm os.FileMode) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func getSSHKeyAlgorithm() string {
+	algorithms := []string{
+		services.SSHKeyAlgorithmEd25519,
+		services.SSHKeyAlgorithmRSA,
+		services.SSHKeyAlgorithmECDSA,
+	}
+	prompt := promptui.Select{
+		Label: "Select key algorithm",
+		Items: algorithms,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose ssh key algorithm prompt failed")
+	}
+
+	return algorithms[idx]
+}
+
+func sshKeyFileSuffix(keyType string) string {
+	switch keyType {
+	case "ssh-ed25519":
+		return services.SSHKeyAlgorithmEd25519
+	case "ssh-rsa":
+		return services.SSHKeyAlgorithmRSA
+	}
+	return services.SSHKeyAlgorithmECDSA
+}
+
+func getConcealedValueFromUser(label string) string {
+	prompt := promptui.Prompt{
+		Label: label,
+		Mask:  '*',
+	}
+
+	value, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant get value from user")
+	}
+
+	return value
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const expiryDateLayout = "2006-01-02"
+
+func getAttributesFromUser() *pb.SecretAttributes {
+	return &pb.SecretAttributes{
+		Tags:   parseTags(getValueFromUser("Enter tags separated by commas (optional)")),
+		Labels: parseLabels(getValueFromUser("Enter labels as key=value separated by commas (optional)")),
+	}
+}
+
+func parseTags(input string) []string {
+	var tags []string
+	for _, tag := range strings.Split(input, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func parseLabels(input string) map[string]string {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(input, ",") {
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			continue
+		}
+		labels[key] = strings.TrimSpace(value)
+	}
+	return labels
+}
+
+func formatAttributes(attributes *pb.SecretAttributes) string {
+	parts := make([]string, 0, len(attributes.GetTags())+len(attributes.GetLabels()))
+	for _, tag := range attributes.GetTags() {
+		parts = append(parts, "#"+tag)
+	}
+
+	keys := make([]string, 0, len(attributes.GetLabels()))
+	for key := range attributes.GetLabels() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		parts = append(parts, key+"="+attributes.GetLabels()[key])
+	}
+
+	if attributes.GetExpiresAt() != nil {
+		parts = append(parts, "expires "+attributes.GetExpiresAt().AsTime().Local().Format(expiryDateLayout))
+	}
+	if attributes.GetRotateEveryDays() > 0 {
+		parts = append(parts, fmt.Sprintf("rotate every %d days", attributes.GetRotateEveryDays()))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func searchSecrets(ctx context.Context, client pb.SecretsClient) {
+	req := &pb.ListSecretsRequest{
+		NamePrefix: getValueFromUser("Enter beginning of secret name (optional)"),
+		Attributes: getAttributesFromUser(),
+	}
+
+	found := 0
+	for {
+		resp, err := client.ListSecrets(ctx, req)
+		if err != nil {
+			fmt.Println("Cant search your secrets!")
+			log.Fatal().Err(err).Msg("cant list secrets from server")
+		}
+
+		for _, secret := range resp.Secrets {
+			found++
+			fmt.Printf("%s (%s) %s\n", secret.Name, models.SecretType(secret.Type), formatAttributes(secret.Attributes))
+		}
+
+		if resp.NextCursor == 0 {
+			break
+		}
+		req.Cursor = resp.NextCursor
+	}
+
+	if found == 0 {
+		fmt.Println("Nothing found")
+	}
+}
+
+func findSecretMetadata(ctx context.Context, client pb.SecretsClient, secretType models.SecretType, name string) *pb.SecretMetadata {
+	req := &pb.ListSecretsRequest{
+		Type:       pb.SecretType(secretType),
+		NamePrefix: name,
+	}
+	for {
+		resp, err := client.ListSecrets(ctx, req)
+		if err != nil {
+			fmt.Println("Cant get your secret!")
+			log.Fatal().Err(err).Msg("cant list secrets from server")
+		}
+
+		for _, secret := range resp.Secrets {
+			if secret.Name == name {
+				return secret
+			}
+		}
+
+		if resp.NextCursor == 0 {
+			return nil
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+func editTags(ctx context.Context, client pb.SecretsClient) {
+	secretType := getSecretType()
+	if secretType == models.SecretTypeBinary {
+		fmt.Println("Files cannot be edited. Delete the file and upload it again")
+		return
+	}
+
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	secret := findSecretMetadata(ctx, client, secretType, secretName)
+	if secret == nil {
+		fmt.Println("Secret not found")
+		return
+	}
+
+	attributes := secret.GetAttributes()
+	if attributes == nil {
+		attributes = &pb.SecretAttributes{}
+	}
+	if attributes.Labels == nil {
+		attributes.Labels = make(map[string]string)
+	}
+	fmt.Printf("Current tags and labels: %s\n", formatAttributes(attributes))
+
+	prompt := promptui.Select{
+		Label: "What would you like to do?",
+		Items: []string{"Add tag", "Remove tag", "Set label", "Remove label", "Set expiry date", "Set rotation period"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose action prompt failed")
+	}
+
+	switch idx {
+	case 0:
+		attributes.Tags = append(attributes.Tags, parseTags(getValueFromUser("Enter tag"))...)
+	case 1:
+		tag := strings.TrimSpace(getValueFromUser("Enter tag"))
+		tags := attributes.Tags[:0]
+		for _, existing := range attributes.Tags {
+			if existing != tag {
+				tags = append(tags, existing)
+			}
+		}
+		attributes.Tags = tags
+	case 2:
+		for key, value := range parseLabels(getValueFromUser("Enter label as key=value")) {
+			attributes.Labels[key] = value
+		}
+	case 3:
+		delete(attributes.Labels, strings.TrimSpace(getValueFromUser("Enter label key")))
+	case 4:
+		attributes.ExpiresAt = getExpiryDateFromUser()
+		attributes.ClearExpiresAt = attributes.ExpiresAt == nil
+	case 5:
+		rotateEveryDays := getNumberFromUser("Enter rotation period in days (0 disables rotation)", int(attributes.GetRotateEveryDays()))
+		attributes.RotateEveryDays = &rotateEveryDays
+	}
+
+	err = saveAttributes(ctx, client, secretType, secretName, attributes)
+	if status.Code(err) == codes.FailedPrecondition {
+		fmt.Println("Secret was changed by someone else while you were editing it. Try again")
+		return
+	}
+	if err != nil {
+		fmt.Println("Cant save tags of your secret!")
+		log.Fatal().Err(err).Msg("cant update secret on server")
+	}
+	fmt.Println("Tags saved!")
+}
+
+// getExpiryDateFromUser returns nil if user enters empty date.
+func getExpiryDateFromUser() *timestamppb.Timestamp {
+	for {
+		input := strings.TrimSpace(getValueFromUser("Enter expiry date as YYYY-MM-DD (empty removes it)"))
+		if input == "" {
+			return nil
+		}
+
+		date, err := time.ParseInLocation(expiryDateLayout, input, time.Local)
+		if err == nil {
+			return timestamppb.New(date)
+		}
+		fmt.Println("Date must be in format YYYY-MM-DD")
+	}
+}
+
+// saveAttributes replaces tags and labels of the secret keeping its data unchanged.
+func saveAttributes(
+	ctx context.Context,
+	client pb.SecretsClient,
+	secretType models.SecretType,
+	secretName string,
+	attributes *pb.SecretAttributes,
+) error {
+	// secret is saved back as is, so it must not be masked
+	current, err := client.RevealSecret(ctx, &pb.GetSecretRequest{
+		Name: secretName,
+		Type: pb.SecretType(secretType),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.PutSecret(ctx, &pb.PutSecretRequest{
+		Name:       secretName,
+		Payload:    current.Payload,
+		Version:    current.Metadata.Version,
+		Attributes: attributes,
+	})
+	return err
+}
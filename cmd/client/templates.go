@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+func manageTemplates(ctx context.Context, client pb.TemplatesClient) {
+	prompt := promptui.Select{
+		Label: "Templates",
+		Items: []string{"List templates", "Create template", "Delete template"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose templates action prompt failed")
+	}
+	if idx == 0 {
+		listTemplates(ctx, client)
+	}
+	if idx == 1 {
+		createTemplate(ctx, client)
+	}
+	if idx == 2 {
+		deleteTemplate(ctx, client)
+	}
+}
+
+func listTemplates(ctx context.Context, client pb.TemplatesClient) {
+	templates := getTemplates(ctx, client)
+	if len(templates) == 0 {
+		fmt.Println("You have no templates")
+		return
+	}
+
+	for _, template := range templates {
+		fmt.Printf("%s:\n", template.Name)
+		for _, field := range template.Fields {
+			required := ""
+			if field.Required {
+				required = ", required"
+			}
+			fmt.Printf("  %s (%s%s)\n", field.Name, fieldKindName(field.Kind), required)
+		}
+	}
+}
+
+func createTemplate(ctx context.Context, client pb.TemplatesClient) {
+	req := &pb.TemplateRequest{
+		Name: getValueFromUser("Enter template name"),
+	}
+
+	for {
+		name := getValueFromUser("Enter field name (empty to finish)")
+		if name == "" {
+			break
+		}
+		req.Fields = append(req.Fields, &pb.TemplateField{
+			Name:     name,
+			Kind:     getFieldKind(),
+			Required: confirm("Is field required"),
+		})
+	}
+
+	_, err := client.CreateTemplate(ctx, req)
+	if err != nil {
+		fmt.Println("Cant create your template!")
+		log.Fatal().Err(err).Msg("cant create template on server")
+	}
+	fmt.Println("Template created!")
+}
+
+func deleteTemplate(ctx context.Context, client pb.TemplatesClient) {
+	template, ok := pickTemplate(ctx, client)
+	if !ok {
+		fmt.Println("You have no templates")
+		return
+	}
+
+	_, err := client.DeleteTemplate(ctx, &pb.TemplateIDRequest{Id: template.Id})
+	if err != nil {
+		fmt.Println("Cant delete your template!")
+		log.Fatal().Err(err).Msg("cant delete template on server")
+	}
+	fmt.Println("Template deleted!")
+}
+
+func getTemplates(ctx context.Context, client pb.TemplatesClient) []*pb.Template {
+	resp, err := client.ListTemplates(ctx, &pb.ListTemplatesRequest{})
+	if err != nil {
+		fmt.Println("Cant list your templates!")
+		log.Fatal().Err(err).Msg("cant list templates from server")
+	}
+	return resp.Templates
+}
+
+func pickTemplate(ctx context.Context, client pb.TemplatesClient) (*pb.Template, bool) {
+	templates := getTemplates(ctx, client)
+	if len(templates) == 0 {
+		return nil, false
+	}
+
+	names := make([]string, 0, len(templates))
+	for _, template := range templates {
+		names = append(names, template.Name)
+	}
+
+	prompt := promptui.Select{
+		Label: "Select template",
+		Items: names,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose template prompt failed")
+	}
+
+	return templates[idx], true
+}
+
+func getFieldKind() pb.FieldKind {
+	kinds := []pb.FieldKind{
+		pb.FieldKind_FIELD_KIND_TEXT,
+		pb.FieldKind_FIELD_KIND_CONCEALED,
+		pb.FieldKind_FIELD_KIND_URL,
+		pb.FieldKind_FIELD_KIND_DATE,
+		pb.FieldKind_FIELD_KIND_MULTILINE,
+	}
+	names := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		names = append(names, fieldKindName(kind))
+	}
+
+	prompt := promptui.Select{
+		Label: "Select field kind",
+		Items: names,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose field kind prompt failed")
+	}
+
+	return kinds[idx]
+}
+
+func fieldKindName(kind pb.FieldKind) string {
+	switch kind {
+	case pb.FieldKind_FIELD_KIND_TEXT:
+		return "Text"
+	case pb.FieldKind_FIELD_KIND_CONCEALED:
+		return "Concealed"
+	case pb.FieldKind_FIELD_KIND_URL:
+		return "URL"
+	case pb.FieldKind_FIELD_KIND_DATE:
+		return "Date"
+	case pb.FieldKind_FIELD_KIND_MULTILINE:
+		return "Multiline"
+	}
+	return ""
+}
+
+// getCustomFieldsFromUser asks values of all template fields. Current values are offered as defaults.
+func getCustomFieldsFromUser(template *pb.Template, current map[string]string) map[string]string {
+	fields := make(map[string]string, len(template.Fields))
+	for _, field := range template.Fields {
+		value := getFieldValueFromUser(field, current[field.Name])
+		if value != "" {
+			fields[field.Name] = value
+		}
+	}
+	return fields
+}
+
+func getFieldValueFromUser(field *pb.TemplateField, defaultValue string) string {
+	label := field.Name
+	if field.Kind == pb.FieldKind_FIELD_KIND_DATE {
+		label += " (YYYY-MM-DD)"
+	}
+
+	if field.Kind == pb.FieldKind_FIELD_KIND_MULTILINE {
+		return getMultilineValueFromUser(label, field.Required)
+	}
+
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   defaultValue,
+		AllowEdit: true,
+		Validate: func(input string) error {
+			if input == "" && defaultValue != "" && field.Kind == pb.FieldKind_FIELD_KIND_CONCEALED {
+				return nil
+			}
+			return validateFieldInput(field, input)
+		},
+	}
+	if field.Kind == pb.FieldKind_FIELD_KIND_CONCEALED {
+		prompt.Mask = '*'
+		prompt.Default = ""
+	}
+
+	value, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant get field value from user")
+	}
+	if value == "" && field.Kind == pb.FieldKind_FIELD_KIND_CONCEALED {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getMultilineValueFromUser reads lines until empty line.
+func getMultilineValueFromUser(label string, required bool) string {
+	fmt.Printf("%s (finish with empty line):\n", label)
+
+	var lines []string
+	for {
+		line := getValueFromUser(">")
+		if line == "" {
+			if required && len(lines) == 0 {
+				fmt.Println("Field is required")
+				continue
+			}
+			return strings.Join(lines, "\n")
+		}
+		lines = append(lines, line)
+	}
+}
+
+func validateFieldInput(field *pb.TemplateField, input string) error {
+	if input == "" {
+		if field.Required {
+			return fmt.Errorf("field is required")
+		}
+		return nil
+	}
+
+	switch field.Kind {
+	case pb.FieldKind_FIELD_KIND_URL:
+		u, err := url.Parse(input)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid url")
+		}
+	case pb.FieldKind_FIELD_KIND_DATE:
+		_, err := time.Parse(services.TemplateDateLayout, input)
+		if err != nil {
+			return fmt.Errorf("invalid date")
+		}
+	}
+
+	return nil
+}
+
+func confirm(label string) bool {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+
+	_, err := prompt.Run()
+	return err == nil
+}
+
+func printCustomFields(data *pb.CustomData, template *pb.Template) {
+	for _, field := range template.Fields {
+		value, ok := data.Fields[field.Name]
+		if !ok {
+			continue
+		}
+		if field.Kind == pb.FieldKind_FIELD_KIND_MULTILINE {
+			fmt.Printf("%s:\n%s\n", field.Name, value)
+			continue
+		}
+		fmt.Printf("%s: %s\n", field.Name, value)
+	}
+}
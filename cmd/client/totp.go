@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+func addTOTP(ctx context.Context, client pb.SecretsClient, secretName string) {
+	var err error
+	if confirm("Import from otpauth:// URI") {
+		_, err = client.ImportTOTP(ctx, &pb.ImportTOTPRequest{
+			Name:       secretName,
+			Uri:        getValueFromUser("Enter otpauth:// URI"),
+			Attributes: getAttributesFromUser(),
+		})
+	} else {
+		_, err = client.PutSecret(ctx, &pb.PutSecretRequest{
+			Name: secretName,
+			Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Totp{Totp: &pb.TOTPData{
+				Secret:      getValueFromUser("Enter base32 seed"),
+				Issuer:      getValueFromUser("Enter issuer (optional)"),
+				AccountName: getValueFromUser("Enter account name (optional)"),
+			}}},
+			Attributes: getAttributesFromUser(),
+		})
+	}
+	if err != nil {
+		fmt.Println("Cant save your secret!")
+		log.Fatal().Err(err).Msg("cant save totp secret on server")
+	}
+	fmt.Println("TOTP secret Saved!")
+}
+
+// showTOTPCode prints the current code and refreshes it when it expires until user presses Enter.
+func showTOTPCode(ctx context.Context, client pb.SecretsClient, secretName string) {
+	stop := make(chan struct{})
+	go func() {
+		_, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+		close(stop)
+	}()
+
+	fmt.Println("Press Enter to stop")
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var code string
+	var remaining int32
+	for {
+		if remaining <= 0 {
+			resp, err := client.GetTOTPCode(ctx, &pb.GetTOTPCodeRequest{Name: secretName})
+			if err != nil {
+				fmt.Println("Cant get your code!")
+				log.Fatal().Err(err).Msg("cant get totp code from server")
+			}
+			code, remaining = resp.Code, resp.SecondsRemaining
+		}
+
+		fmt.Printf("\rCode: %s (%2ds left)", code, remaining)
+
+		select {
+		case <-stop:
+			fmt.Println()
+			return
+		case <-ticker.C:
+			remaining--
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+func deleteSecret(ctx context.Context, client pb.SecretsClient) {
+	secretType := getSecretType()
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	req := &pb.DeleteSecretRequest{
+		Name: secretName,
+		Type: pb.SecretType(secretType),
+	}
+	_, err := client.DeleteSecret(ctx, req)
+	if err != nil {
+		fmt.Println("Cant delete your secret!")
+		log.Fatal().Err(err).Msg("cant delete secret on server")
+	}
+	fmt.Println("Secret moved to trash!")
+}
+
+func listTrash(ctx context.Context, client pb.SecretsClient) []*pb.SecretMetadata {
+	var secrets []*pb.SecretMetadata
+
+	req := &pb.ListTrashRequest{}
+	for {
+		resp, err := client.ListTrash(ctx, req)
+		if err != nil {
+			fmt.Println("Cant list your trash!")
+			log.Fatal().Err(err).Msg("cant list trash from server")
+		}
+
+		secrets = append(secrets, resp.Secrets...)
+
+		if resp.NextCursor == 0 {
+			return secrets
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+func manageTrash(ctx context.Context, client pb.SecretsClient) {
+	secrets := listTrash(ctx, client)
+	if len(secrets) == 0 {
+		fmt.Println("Trash is empty")
+		return
+	}
+
+	items := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		items = append(items, fmt.Sprintf(
+			"%s (%s), deleted at %s",
+			secret.Name,
+			models.SecretType(secret.Type),
+			secret.DeletedAt.AsTime().Local().Format("2006-01-02 15:04"),
+		))
+	}
+
+	selectSecret := promptui.Select{
+		Label: "Select secret",
+		Items: items,
+	}
+	idx, _, err := selectSecret.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose secret prompt failed")
+	}
+
+	selectAction := promptui.Select{
+		Label: "What would you like to do?",
+		Items: []string{"Restore", "Delete forever"},
+	}
+	action, _, err := selectAction.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose action prompt failed")
+	}
+
+	req := &pb.TrashedSecretRequest{Id: secrets[idx].Id}
+	if action == 0 {
+		_, err = client.RestoreSecret(ctx, req)
+		if err != nil {
+			fmt.Println("Cant restore your secret! Maybe secret with the same name already exists")
+			log.Error().Err(err).Msg("cant restore secret on server")
+			return
+		}
+		fmt.Println("Secret restored!")
+		return
+	}
+
+	_, err = client.PurgeSecret(ctx, req)
+	if err != nil {
+		fmt.Println("Cant delete your secret!")
+		log.Fatal().Err(err).Msg("cant purge secret on server")
+	}
+	fmt.Println("Secret deleted forever!")
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func updateSecret(ctx context.Context, c clients) {
+	client := c.secrets
+	secretType := getSecretType()
+	secretName, ok := pickSecretName(ctx, client, secretType)
+	if !ok {
+		fmt.Println("You have no secrets of this type")
+		return
+	}
+
+	if secretType == models.SecretTypeBinary {
+		fmt.Println("Files cannot be edited. Delete the file and upload it again")
+		return
+	}
+
+	if secretType == models.SecretTypeTOTP || secretType == models.SecretTypeSSHKey {
+		fmt.Printf("%s secrets cannot be edited. Delete the secret and add it again\n", secretType)
+		return
+	}
+
+	req := &pb.GetSecretRequest{Name: secretName}
+
+	var err error
+	switch secretType {
+	case models.SecretTypePassword:
+		var current *pb.PasswordResponse
+		current, err = client.GetPassword(ctx, req)
+		if err != nil {
+			fmt.Println("Cant get your secret!")
+			log.Fatal().Err(err).Msg("cant get password from server")
+		}
+		login := getValueFromUserWithDefault("Enter login", current.Login)
+		_, err = client.UpdatePassword(ctx, &pb.UpdatePasswordRequest{
+			Name:     secretName,
+			Login:    login,
+			Password: getPasswordFromUser(ctx, c, current.Password, login, secretName),
+			Version:  current.Version,
+			Urls:     getURLsFromUser(current.Urls),
+		})
+	case models.SecretTypeCard:
+		var current *pb.CardResponse
+		current, err = client.RevealCard(ctx, req)
+		if err != nil {
+			fmt.Println("Cant get your secret!")
+			log.Fatal().Err(err).Msg("cant get card from server")
+		}
+		_, err = client.UpdateCard(ctx, &pb.UpdateCardRequest{
+			CardName:   secretName,
+			Number:     getValueFromUserWithDefault("Enter card number", current.Number),
+			HolderName: getValueFromUserWithDefault("Enter card holder name", current.HolderName),
+			Date:       getValueFromUserWithDefault("Enter card expiry date (MM/YY)", current.Date),
+			Ccv:        getValueFromUserWithDefault("Enter card ccv", current.Ccv),
+			Version:    current.Version,
+		})
+	case models.SecretTypeText:
+		var current *pb.TextResponse
+		current, err = client.GetText(ctx, req)
+		if err != nil {
+			fmt.Println("Cant get your secret!")
+			log.Fatal().Err(err).Msg("cant get text from server")
+		}
+		_, err = client.UpdateText(ctx, &pb.UpdateTextRequest{
+			Name:    secretName,
+			Text:    getValueFromUserWithDefault("Enter text", current.Text),
+			Version: current.Version,
+		})
+	case models.SecretTypeCustom:
+		err = updateCustomSecret(ctx, client, c.templates, secretName)
+	}
+
+	if status.Code(err) == codes.FailedPrecondition {
+		fmt.Println("Secret was changed by someone else while you were editing it. Get it again and retry")
+		return
+	}
+	if err != nil {
+		fmt.Println("Cant update your secret!")
+		log.Fatal().Err(err).Msg("cant update secret on server")
+	}
+	fmt.Println("Secret updated!")
+}
+
+func updateCustomSecret(ctx context.Context, client pb.SecretsClient, templatesClient pb.TemplatesClient, secretName string) error {
+	current, err := client.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: secretName,
+		Type: pb.SecretType_SECRET_TYPE_CUSTOM,
+	})
+	if err != nil {
+		fmt.Println("Cant get your secret!")
+		log.Fatal().Err(err).Msg("cant get custom secret from server")
+	}
+
+	custom := current.Payload.GetCustom()
+	template, err := templatesClient.GetTemplate(ctx, &pb.TemplateIDRequest{Id: custom.GetTemplateId()})
+	if err != nil {
+		fmt.Println("Cant get template of your secret!")
+		log.Fatal().Err(err).Msg("cant get template from server")
+	}
+
+	_, err = client.PutSecret(ctx, &pb.PutSecretRequest{
+		Name: secretName,
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Custom{Custom: &pb.CustomData{
+			TemplateId: template.Id,
+			Fields:     getCustomFieldsFromUser(template, custom.GetFields()),
+		}}},
+		Version: current.Metadata.GetVersion(),
+	})
+	return err
+}
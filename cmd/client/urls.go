@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+//nolint:gochecknoglobals
+var urlMatchNames = map[pb.URLMatch]string{
+	pb.URLMatch_URL_MATCH_BASE_DOMAIN: "base domain",
+	pb.URLMatch_URL_MATCH_HOST:        "exact host",
+	pb.URLMatch_URL_MATCH_PREFIX:      "starts with",
+	pb.URLMatch_URL_MATCH_REGEX:       "regular expression",
+}
+
+// getURLsFromUser asks websites of password. Current urls are kept unless user wants to change them.
+func getURLsFromUser(current []*pb.PasswordURL) []*pb.PasswordURL {
+	if len(current) > 0 {
+		fmt.Printf("Current websites: %s\n", formatURLs(current))
+		if !confirm("Change websites") {
+			return current
+		}
+	}
+
+	var urls []*pb.PasswordURL
+	for confirm("Add website") {
+		urls = append(urls, &pb.PasswordURL{
+			Url:   getValueFromUser("Enter website url"),
+			Match: getURLMatchFromUser(),
+		})
+	}
+	return urls
+}
+
+func getURLMatchFromUser() pb.URLMatch {
+	matches := []pb.URLMatch{
+		pb.URLMatch_URL_MATCH_BASE_DOMAIN,
+		pb.URLMatch_URL_MATCH_HOST,
+		pb.URLMatch_URL_MATCH_PREFIX,
+		pb.URLMatch_URL_MATCH_REGEX,
+	}
+	items := make([]string, 0, len(matches))
+	for _, match := range matches {
+		items = append(items, urlMatchNames[match])
+	}
+
+	prompt := promptui.Select{
+		Label: "How should the url be matched?",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose url match prompt failed")
+	}
+
+	return matches[idx]
+}
+
+func formatURLs(urls []*pb.PasswordURL) string {
+	parts := make([]string, 0, len(urls))
+	for _, passwordURL := range urls {
+		match, ok := urlMatchNames[passwordURL.Match]
+		if !ok {
+			match = urlMatchNames[pb.URLMatch_URL_MATCH_BASE_DOMAIN]
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s)", passwordURL.Url, match))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func findByURL(ctx context.Context, client pb.SecretsClient) {
+	resp, err := client.FindByURL(ctx, &pb.FindByURLRequest{Url: getValueFromUser("Enter url")})
+	if err != nil {
+		fmt.Println("Cant find your passwords!")
+		log.Fatal().Err(err).Msg("cant find passwords by url on server")
+	}
+	if len(resp.Credentials) == 0 {
+		fmt.Println("Nothing found")
+		return
+	}
+
+	for _, credential := range resp.Credentials {
+		fmt.Printf("%s\n", credential.Metadata.Name)
+		fmt.Printf("  Login: %s\n", credential.Password.Login)
+		fmt.Printf("  Password: %s\n", credential.Password.Password)
+	}
+}
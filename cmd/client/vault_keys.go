@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
+)
+
+func manageVaultKey(ctx context.Context, client pb.SecretsClient) {
+	selectAction := promptui.Select{
+		Label: "What would you like to do?",
+		Items: []string{"Rotate vault key", "Delete vault key"},
+	}
+	idx, _, err := selectAction.Run()
+	if err != nil {
+		log.Fatal().Err(err).Msg("choose action prompt failed")
+	}
+
+	if idx == 0 {
+		_, err = client.RotateVaultKey(ctx, &pb.Empty{})
+		if err != nil {
+			fmt.Println("Cant rotate vault key!")
+			log.Fatal().Err(err).Msg("cant rotate vault key on server")
+		}
+		fmt.Println("Vault key rotated, secrets will be reencrypted with the new key!")
+		return
+	}
+
+	confirm := promptui.Prompt{
+		Label:     "All secrets of the vault will be lost. Delete vault key",
+		IsConfirm: true,
+	}
+	if _, err = confirm.Run(); err != nil {
+		return
+	}
+
+	_, err = client.DeleteVaultKey(ctx, &pb.Empty{})
+	if err != nil {
+		fmt.Println("Cant delete vault key!")
+		log.Fatal().Err(err).Msg("cant delete vault key on server")
+	}
+	fmt.Println("Vault key deleted!")
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/proto"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"github.com/rs/zerolog/log"
+)
+
+// unlockVault derives vault key from master password and makes vault encrypt secrets of personal vault with it.
+// Master password is not sent to the server, so it can't be recovered if it is lost.
+// It is verified with key check that is stored with kdf params, the first unlock saves key check of the entered password.
+func unlockVault(ctx context.Context, client pb.AuthClient, vault *proto.ClientVaultInterceptor) {
+	params, err := client.GetKDFParams(ctx, &pb.KDFParamsRequest{})
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant get kdf params from server")
+	}
+	if len(params.Salt) == 0 || params.Threads == 0 {
+		log.Fatal().Msg("server returned invalid kdf params")
+	}
+
+	for {
+		masterPassword := getConcealedValueFromUser("Enter your master password")
+		fmt.Println("Deriving vault key...")
+		key := services.DeriveVaultKey(masterPassword, models.KDFParams{
+			Salt:    params.Salt,
+			Time:    params.Time,
+			Memory:  params.Memory,
+			Threads: uint8(params.Threads),
+		})
+
+		if len(params.KeyCheck) == 0 {
+			params = saveVaultKeyCheck(ctx, client, key)
+		}
+		if services.VerifyVaultKey(key, params.KeyCheck) {
+			vault.Unlock(key)
+			break
+		}
+		fmt.Println("Wrong master password!")
+	}
+	fmt.Println("Vault unlocked, secrets are encrypted before they are sent to the server")
+}
+
+// saveVaultKeyCheck saves key check of the vault key and returns params with key check that is saved,
+// it differs from the new one if another client has unlocked the vault first.
+func saveVaultKeyCheck(ctx context.Context, client pb.AuthClient, key []byte) *pb.KDFParams {
+	keyCheck, err := services.NewVaultKeyCheck(key, &services.TrulyRandomGenerator{})
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant make vault key check")
+	}
+
+	params, err := client.SetKDFKeyCheck(ctx, &pb.KDFKeyCheckRequest{KeyCheck: keyCheck})
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant save vault key check on server")
+	}
+
+	return params
+}
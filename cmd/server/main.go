@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	grpc2 "github.com/belamov/ypgo-password-manager/internal/app/grpc"
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
 	"github.com/belamov/ypgo-password-manager/internal/app/proto"
 	"github.com/belamov/ypgo-password-manager/internal/app/services"
 	"github.com/belamov/ypgo-password-manager/internal/app/storage"
@@ -28,6 +30,20 @@ var (
 	buildCommit  = "N/A" //nolint:gochecknoglobals
 
 	tokenDuration = 15 * time.Minute
+
+	trashRetention     = 30 * 24 * time.Hour
+	trashPurgeInterval = time.Hour
+
+	maxSecretRevisions = 20
+
+	// secrets that are due within dueNotificationWindow are notified every dueNotificationInterval
+	dueNotificationWindow   = 7 * 24 * time.Hour
+	dueNotificationInterval = time.Hour
+
+	// secrets that are encrypted with the master key or with retired data keys
+	// are moved to current data keys of their vaults
+	reencryptionInterval  = time.Hour
+	reencryptionBatchSize = 100
 )
 
 const (
@@ -47,6 +63,10 @@ func main() {
 	port := os.Getenv("port")
 	dsn := os.Getenv("dsn")
 	secretkey := os.Getenv("secret_key")
+	// previous_secret_key is set once after secret_key is changed to rewrap data keys with the new one
+	previousSecretKey := os.Getenv("previous_secret_key")
+	breachCorpus := os.Getenv("breached_passwords_corpus")
+	breachIndex := os.Getenv("breached_passwords_index")
 
 	enableTLS = false
 	port = "9000"
@@ -65,46 +85,178 @@ func main() {
 		log.Fatal().Err(err).Msg("cant init user repo")
 	}
 
-	secretsRepo, err := storage.NewSecretsRepository(ctx, dsn)
+	secretsRepo, err := storage.NewSecretsRepository(ctx, dsn, maxSecretRevisions)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cant init secrets repo")
 	}
 
+	templatesRepo, err := storage.NewTemplatesRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init templates repo")
+	}
+
+	notificationsRepo, err := storage.NewNotificationsRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init notifications repo")
+	}
+
+	foldersRepo, err := storage.NewFoldersRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init folders repo")
+	}
+
+	organizationsRepo, err := storage.NewOrganizationsRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init organizations repo")
+	}
+
+	emergencyRepo, err := storage.NewEmergencyAccessRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init emergency access repo")
+	}
+
+	auditRepo, err := storage.NewAuditRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init audit repo")
+	}
+
+	dataKeysRepo, err := storage.NewDataKeysRepository(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cant init data keys repo")
+	}
+
 	jwtManager := services.NewJWTManager(secretkey, tokenDuration)
 	authService := services.NewAuthService(usersRepo)
 
+	// secret key is the master key that wraps data keys of vaults
 	crypto := &services.GCMAESCryptographer{
 		Random: &services.TrulyRandomGenerator{},
 		Key:    []byte(secretkey),
 	}
-	secretsService := services.NewSecretsService(secretsRepo, crypto)
+	dataKeysService := services.NewDataKeysService(dataKeysRepo, crypto, &services.TrulyRandomGenerator{})
+	if previousSecretKey != "" {
+		err = dataKeysService.RewrapDataKeys(&services.GCMAESCryptographer{
+			Random: &services.TrulyRandomGenerator{},
+			Key:    []byte(previousSecretKey),
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("cant rewrap data keys with new secret key")
+		}
+	}
+	secretsService := services.NewSecretsService(secretsRepo, dataKeysService, &services.TrulyRandomGenerator{})
+	templatesService := services.NewTemplatesService(templatesRepo)
+	secretsService.RegisterValidator(models.SecretTypeCustom, templatesService)
+	passwordsService := services.NewPasswordsService(usersRepo, &services.TrulyRandomGenerator{})
+	secretsService.RegisterValidator(models.SecretTypePassword, passwordsService)
+	if breachIndex != "" {
+		index, err := openBreachIndex(breachCorpus, breachIndex)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cant open breached passwords index")
+		}
+		defer index.Close()
+		passwordsService.UseBreachIndex(index)
+	}
+
+	notificationsService := services.NewNotificationsService(secretsRepo, notificationsRepo)
+	foldersService := services.NewFoldersService(foldersRepo)
+	organizationsService := services.NewOrganizationsService(organizationsRepo)
+	emergencyService := services.NewEmergencyAccessService(emergencyRepo)
+	auditService := services.NewAuditService(auditRepo)
+
+	go runPeriodically(ctx, trashPurgeInterval, func() {
+		_ = secretsService.PurgeExpiredTrash(trashRetention)
+	})
+	go runPeriodically(ctx, trashPurgeInterval, func() {
+		_ = secretsService.PurgeShareLinks()
+	})
+	go runPeriodically(ctx, dueNotificationInterval, func() {
+		_ = notificationsService.NotifyDue(dueNotificationWindow)
+	})
+	go runPeriodically(ctx, reencryptionInterval, func() {
+		if secretsService.ReencryptRetiredSecrets(reencryptionBatchSize) == nil {
+			_ = dataKeysService.PurgeRetiredKeys()
+		}
+	})
 
 	address := fmt.Sprintf("0.0.0.0:%s", port)
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot start server")
 	}
-	err = runGRPCServer(authService, secretsService, jwtManager, enableTLS, listener)
+	err = runGRPCServer(
+		authService,
+		secretsService,
+		templatesService,
+		passwordsService,
+		notificationsService,
+		foldersService,
+		organizationsService,
+		emergencyService,
+		auditService,
+		jwtManager,
+		enableTLS,
+		listener,
+	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot start server")
 	}
 }
 
+// openBreachIndex opens index of breached passwords building it from corpus first if index does not exist.
+func openBreachIndex(corpus string, indexPath string) (*services.BreachIndex, error) {
+	_, err := os.Stat(indexPath)
+	if errors.Is(err, os.ErrNotExist) && corpus != "" {
+		log.Info().Msgf("Building breached passwords index %s from %s", indexPath, corpus)
+		err = services.BuildBreachIndex(corpus, indexPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return services.OpenBreachIndex(indexPath)
+}
+
+// runPeriodically calls job every interval until ctx is done.
+func runPeriodically(ctx context.Context, interval time.Duration, job func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func runGRPCServer(
 	authService *services.AuthService,
 	secretsService *services.SecretsManager,
+	templatesService *services.TemplatesManager,
+	passwordsService *services.PasswordsManager,
+	notificationsService *services.NotificationsManager,
+	foldersService *services.FoldersManager,
+	organizationsService *services.OrganizationsManager,
+	emergencyService *services.EmergencyAccessManager,
+	auditService *services.AuditManager,
 	jwtManager *services.JWTManager,
 	enableTLS bool,
 	listener net.Listener,
 ) error {
-	interceptor := proto.NewAuthInterceptor(jwtManager)
+	interceptor := proto.NewAuthInterceptor(jwtManager, organizationsService, emergencyService)
+	auditInterceptor := proto.NewAuditInterceptor(jwtManager, auditService)
 	serverOptions := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_recovery.UnaryServerInterceptor(),
+			auditInterceptor.Unary(),
 			interceptor.Unary(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_recovery.StreamServerInterceptor(),
+			auditInterceptor.Stream(),
 			interceptor.Stream(),
 		)),
 	}
@@ -121,10 +273,24 @@ func runGRPCServer(
 	grpcServer := grpc.NewServer(serverOptions...)
 
 	authGRCP := grpc2.NewAuthServerService(authService, jwtManager)
-	secretsGRPC := grpc2.NewSecretsServerService(secretsService, jwtManager)
+	secretsGRPC := grpc2.NewSecretsServerService(secretsService, passwordsService, jwtManager)
+	templatesGRPC := grpc2.NewTemplatesServerService(templatesService, jwtManager)
+	passwordsGRPC := grpc2.NewPasswordsServerService(passwordsService, secretsService, jwtManager)
+	notificationsGRPC := grpc2.NewNotificationsServerService(notificationsService, jwtManager)
+	foldersGRPC := grpc2.NewFoldersServerService(foldersService, jwtManager)
+	organizationsGRPC := grpc2.NewOrganizationsServerService(organizationsService, jwtManager)
+	emergencyGRPC := grpc2.NewEmergencyAccessServerService(emergencyService, jwtManager)
+	auditGRPC := grpc2.NewAuditServerService(auditService, jwtManager)
 
 	pb.RegisterAuthServer(grpcServer, authGRCP)
 	pb.RegisterSecretsServer(grpcServer, secretsGRPC)
+	pb.RegisterTemplatesServer(grpcServer, templatesGRPC)
+	pb.RegisterPasswordsServer(grpcServer, passwordsGRPC)
+	pb.RegisterNotificationsServer(grpcServer, notificationsGRPC)
+	pb.RegisterFoldersServer(grpcServer, foldersGRPC)
+	pb.RegisterOrganizationsServer(grpcServer, organizationsGRPC)
+	pb.RegisterEmergencyAccessServer(grpcServer, emergencyGRPC)
+	pb.RegisterAuditServer(grpcServer, auditGRPC)
 
 	log.Info().Msgf("Start GRPC server at %s, TLS = %t", listener.Addr().String(), enableTLS)
 	return grpcServer.Serve(listener)
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditGRPC struct {
+	pb.UnimplementedAuditServer
+	auditService services.AuditManagerInterface
+	jwtManager   *services.JWTManager
+}
+
+func NewAuditServerService(service services.AuditManagerInterface, manager *services.JWTManager) *AuditGRPC {
+	return &AuditGRPC{auditService: service, jwtManager: manager}
+}
+
+func (a *AuditGRPC) ListAuditEvents(ctx context.Context, request *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	userID, err := userIDFromContext(ctx, a.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := models.AuditFilter{
+		Cursor: request.GetCursor(),
+		UserID: userID,
+		Limit:  int(request.GetLimit()),
+	}
+	if request.GetFrom() != nil {
+		filter.From = request.GetFrom().AsTime()
+	}
+	if request.GetTo() != nil {
+		filter.To = request.GetTo().AsTime()
+	}
+	for _, action := range request.GetActions() {
+		filter.Actions = append(filter.Actions, models.AuditAction(action))
+	}
+
+	events, nextCursor, err := a.auditService.ListAuditEvents(filter)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot list audit events")
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		Events:     make([]*pb.AuditEvent, 0, len(events)),
+		NextCursor: nextCursor,
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, &pb.AuditEvent{
+			Action:        pb.AuditAction(event.Action),
+			Method:        event.Method,
+			SecretId:      event.SecretID,
+			SecretType:    pb.SecretType(event.SecretType),
+			SecretName:    event.SecretName,
+			ClientAddress: event.ClientAddress,
+			Outcome:       event.Outcome,
+			CreatedAt:     timestamppb.New(event.CreatedAt),
+			Actor:         event.Actor,
+			CollectionId:  event.CollectionID,
+		})
+	}
+
+	return response, nil
+}
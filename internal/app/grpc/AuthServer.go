@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
 	"github.com/belamov/ypgo-password-manager/internal/app/services"
 	"github.com/belamov/ypgo-password-manager/pb"
 	"google.golang.org/grpc/codes"
@@ -21,7 +22,7 @@ func NewAuthServerService(service *services.AuthService, manager *services.JWTMa
 func (a *AuthGRCP) Login(_ context.Context, request *pb.LoginRequest) (*pb.LoginResponse, error) {
 	user, err := a.authService.Login(request.Username, request.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot login user", err)
+		return nil, status.Errorf(codes.Internal, "cannot login user: %v", err)
 	}
 
 	token, err := a.jwtManager.Generate(user)
@@ -36,7 +37,7 @@ func (a *AuthGRCP) Login(_ context.Context, request *pb.LoginRequest) (*pb.Login
 func (a *AuthGRCP) Register(_ context.Context, request *pb.RegisterRequest) (*pb.RegisterResponse, error) {
 	user, err := a.authService.Register(request.Username, request.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot register user", err)
+		return nil, status.Errorf(codes.Internal, "cannot register user: %v", err)
 	}
 
 	token, err := a.jwtManager.Generate(user)
@@ -47,3 +48,47 @@ func (a *AuthGRCP) Register(_ context.Context, request *pb.RegisterRequest) (*pb
 	res := &pb.RegisterResponse{AccessToken: token}
 	return res, nil
 }
+
+func (a *AuthGRCP) GetKDFParams(ctx context.Context, _ *pb.KDFParamsRequest) (*pb.KDFParams, error) {
+	userID, err := userIDFromContext(ctx, a.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := a.authService.GetKDFParams(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get kdf params: %v", err)
+	}
+
+	return kdfParamsToPB(params), nil
+}
+
+func (a *AuthGRCP) SetKDFKeyCheck(ctx context.Context, request *pb.KDFKeyCheckRequest) (*pb.KDFParams, error) {
+	userID, err := userIDFromContext(ctx, a.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.GetKeyCheck()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "key check is required")
+	}
+
+	params, err := a.authService.SetKDFKeyCheck(userID, request.GetKeyCheck())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot set kdf key check: %v", err)
+	}
+	if params.Salt == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "kdf params are not set yet")
+	}
+
+	return kdfParamsToPB(params), nil
+}
+
+func kdfParamsToPB(params models.KDFParams) *pb.KDFParams {
+	return &pb.KDFParams{
+		Salt:     params.Salt,
+		Time:     params.Time,
+		Memory:   params.Memory,
+		Threads:  uint32(params.Threads),
+		KeyCheck: params.KeyCheck,
+	}
+}
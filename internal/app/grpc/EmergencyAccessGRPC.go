@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EmergencyAccessGRPC struct {
+	pb.UnimplementedEmergencyAccessServer
+	emergencyService services.EmergencyAccessManagerInterface
+	jwtManager       *services.JWTManager
+}
+
+func NewEmergencyAccessServerService(service services.EmergencyAccessManagerInterface, manager *services.JWTManager) *EmergencyAccessGRPC {
+	return &EmergencyAccessGRPC{emergencyService: service, jwtManager: manager}
+}
+
+func (e *EmergencyAccessGRPC) NominateContact(ctx context.Context, request *pb.NominateContactRequest) (*pb.EmergencyAccessResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.emergencyService.NominateContact(userID, request.GetUsername(), time.Duration(request.GetWaitHours())*time.Hour)
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot nominate emergency contact")
+	}
+
+	return &pb.EmergencyAccessResponse{}, nil
+}
+
+func (e *EmergencyAccessGRPC) RevokeContact(ctx context.Context, request *pb.ContactRequest) (*pb.EmergencyAccessResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.emergencyService.RevokeContact(userID, request.GetUsername())
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot revoke emergency contact")
+	}
+
+	return &pb.EmergencyAccessResponse{}, nil
+}
+
+func (e *EmergencyAccessGRPC) ListContacts(ctx context.Context, _ *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := e.emergencyService.ListContacts(userID)
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot list emergency contacts")
+	}
+
+	return contactsToPB(contacts), nil
+}
+
+func (e *EmergencyAccessGRPC) ApproveRequest(ctx context.Context, request *pb.ContactRequest) (*pb.EmergencyAccessResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.emergencyService.ApproveRequest(userID, request.GetUsername())
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot approve emergency access")
+	}
+
+	return &pb.EmergencyAccessResponse{}, nil
+}
+
+func (e *EmergencyAccessGRPC) RejectRequest(ctx context.Context, request *pb.ContactRequest) (*pb.EmergencyAccessResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.emergencyService.RejectRequest(userID, request.GetUsername())
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot reject emergency access")
+	}
+
+	return &pb.EmergencyAccessResponse{}, nil
+}
+
+func (e *EmergencyAccessGRPC) ListGrantors(ctx context.Context, _ *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	grantors, err := e.emergencyService.ListGrantors(userID)
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot list emergency grantors")
+	}
+
+	return contactsToPB(grantors), nil
+}
+
+func (e *EmergencyAccessGRPC) RequestAccess(ctx context.Context, request *pb.RequestAccessRequest) (*pb.EmergencyContact, error) {
+	userID, err := userIDFromContext(ctx, e.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := e.emergencyService.RequestAccess(userID, request.GetOwner())
+	if err != nil {
+		return nil, emergencyAccessErrorToStatus(err, "cannot request emergency access")
+	}
+
+	return contactToPB(*contact), nil
+}
+
+func contactsToPB(contacts []models.EmergencyContact) *pb.ListContactsResponse {
+	response := &pb.ListContactsResponse{Contacts: make([]*pb.EmergencyContact, 0, len(contacts))}
+	for _, contact := range contacts {
+		response.Contacts = append(response.Contacts, contactToPB(contact))
+	}
+
+	return response
+}
+
+func contactToPB(contact models.EmergencyContact) *pb.EmergencyContact {
+	result := &pb.EmergencyContact{
+		OwnerId:       int32(contact.OwnerID),
+		Owner:         contact.OwnerName,
+		Contact:       contact.ContactName,
+		WaitHours:     int32(contact.WaitPeriod / time.Hour),
+		Status:        pb.EmergencyAccessStatus(contact.Status),
+		AccessGranted: contact.AccessGranted(time.Now()),
+		CreatedAt:     timestamppb.New(contact.CreatedAt),
+	}
+	if contact.RequestedAt != nil {
+		result.RequestedAt = timestamppb.New(*contact.RequestedAt)
+	}
+
+	return result
+}
+
+// emergencyAccessErrorToStatus converts errors of emergency access to grpc status with matching code.
+func emergencyAccessErrorToStatus(err error, msg string) error {
+	var userNotFoundErr *storage.UserNotFoundError
+	if errors.As(err, &userNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var contactNotFoundErr *storage.EmergencyContactNotFoundError
+	if errors.As(err, &contactNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var contactIsOwnerErr *storage.EmergencyContactIsOwnerError
+	if errors.As(err, &contactIsOwnerErr) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	}
+
+	if errors.Is(err, services.ErrNoEmergencyRequest) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
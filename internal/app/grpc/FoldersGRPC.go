@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FoldersGRPC struct {
+	pb.UnimplementedFoldersServer
+	foldersService services.FoldersManagerInterface
+	jwtManager     *services.JWTManager
+}
+
+func NewFoldersServerService(service services.FoldersManagerInterface, manager *services.JWTManager) *FoldersGRPC {
+	return &FoldersGRPC{foldersService: service, jwtManager: manager}
+}
+
+func (f *FoldersGRPC) CreateFolder(ctx context.Context, request *pb.FolderRequest) (*pb.Folder, error) {
+	userID, collectionID, err := vaultFromContext(ctx, f.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := f.foldersService.CreateFolder(userID, collectionID, request.GetPath())
+	if err != nil {
+		return nil, folderErrorToStatus(err, "cannot create folder")
+	}
+
+	return &pb.Folder{Path: path}, nil
+}
+
+func (f *FoldersGRPC) RenameFolder(ctx context.Context, request *pb.RenameFolderRequest) (*pb.Folder, error) {
+	userID, collectionID, err := vaultFromContext(ctx, f.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := f.foldersService.RenameFolder(userID, collectionID, request.GetPath(), request.GetNewName())
+	if err != nil {
+		return nil, folderErrorToStatus(err, "cannot rename folder")
+	}
+
+	return &pb.Folder{Path: path}, nil
+}
+
+func (f *FoldersGRPC) MoveFolder(ctx context.Context, request *pb.MoveFolderRequest) (*pb.Folder, error) {
+	userID, collectionID, err := vaultFromContext(ctx, f.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := f.foldersService.MoveFolder(userID, collectionID, request.GetPath(), request.GetNewParent())
+	if err != nil {
+		return nil, folderErrorToStatus(err, "cannot move folder")
+	}
+
+	return &pb.Folder{Path: path}, nil
+}
+
+func (f *FoldersGRPC) DeleteFolder(ctx context.Context, request *pb.FolderRequest) (*pb.DeleteFolderResponse, error) {
+	userID, collectionID, err := vaultFromContext(ctx, f.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.foldersService.DeleteFolder(userID, collectionID, request.GetPath())
+	if err != nil {
+		return nil, folderErrorToStatus(err, "cannot delete folder")
+	}
+
+	return &pb.DeleteFolderResponse{}, nil
+}
+
+func (f *FoldersGRPC) ListFolder(ctx context.Context, request *pb.FolderRequest) (*pb.FolderContents, error) {
+	userID, collectionID, err := vaultFromContext(ctx, f.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := f.foldersService.ListFolder(userID, collectionID, request.GetPath())
+	if err != nil {
+		return nil, folderErrorToStatus(err, "cannot list folder")
+	}
+
+	response := &pb.FolderContents{
+		Path:    contents.Path,
+		Folders: contents.Folders,
+		Secrets: make([]*pb.SecretMetadata, 0, len(contents.Secrets)),
+	}
+	for _, secret := range contents.Secrets {
+		response.Secrets = append(response.Secrets, secretMetadataToPB(secret))
+	}
+
+	return response, nil
+}
+
+// folderErrorToStatus converts errors of folders to grpc status with matching code.
+func folderErrorToStatus(err error, msg string) error {
+	var notUniqueFolderErr *storage.NotUniqueFolderError
+	if errors.As(err, &notUniqueFolderErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	var folderNotFoundErr *storage.FolderNotFoundError
+	if errors.As(err, &folderNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var folderNotEmptyErr *storage.FolderNotEmptyError
+	if errors.As(err, &folderNotEmptyErr) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NotificationsGRPC struct {
+	pb.UnimplementedNotificationsServer
+	notificationsService services.NotificationsManagerInterface
+	jwtManager           *services.JWTManager
+}
+
+func NewNotificationsServerService(
+	service services.NotificationsManagerInterface,
+	manager *services.JWTManager,
+) *NotificationsGRPC {
+	return &NotificationsGRPC{notificationsService: service, jwtManager: manager}
+}
+
+func (n *NotificationsGRPC) ListNotifications(
+	ctx context.Context,
+	_ *pb.ListNotificationsRequest,
+) (*pb.ListNotificationsResponse, error) {
+	userID, err := userIDFromContext(ctx, n.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := n.notificationsService.ListNotifications(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list notifications")
+	}
+
+	response := &pb.ListNotificationsResponse{Notifications: make([]*pb.Notification, 0, len(notifications))}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, &pb.Notification{
+			Id:         notification.ID,
+			SecretName: notification.SecretName,
+			SecretType: pb.SecretType(notification.SecretType),
+			Reason:     dueReasonToPB(notification.Reason),
+			DueAt:      timestamppb.New(notification.DueAt),
+			CreatedAt:  timestamppb.New(notification.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrganizationsGRPC struct {
+	pb.UnimplementedOrganizationsServer
+	organizationsService services.OrganizationsManagerInterface
+	jwtManager           *services.JWTManager
+}
+
+func NewOrganizationsServerService(service services.OrganizationsManagerInterface, manager *services.JWTManager) *OrganizationsGRPC {
+	return &OrganizationsGRPC{organizationsService: service, jwtManager: manager}
+}
+
+func (o *OrganizationsGRPC) CreateOrganization(ctx context.Context, request *pb.CreateOrganizationRequest) (*pb.Organization, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := o.organizationsService.CreateOrganization(userID, request.GetName())
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot create organization")
+	}
+
+	return organizationToPB(*organization), nil
+}
+
+func (o *OrganizationsGRPC) ListOrganizations(ctx context.Context, _ *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := o.organizationsService.ListOrganizations(userID)
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot list organizations")
+	}
+
+	response := &pb.ListOrganizationsResponse{Organizations: make([]*pb.Organization, 0, len(organizations))}
+	for _, organization := range organizations {
+		response.Organizations = append(response.Organizations, organizationToPB(organization))
+	}
+
+	return response, nil
+}
+
+func (o *OrganizationsGRPC) ListMembers(ctx context.Context, request *pb.OrganizationRequest) (*pb.ListMembersResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := o.organizationsService.ListMembers(userID, request.GetOrganizationId())
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot list members")
+	}
+
+	response := &pb.ListMembersResponse{Members: make([]*pb.Member, 0, len(members))}
+	for _, member := range members {
+		response.Members = append(response.Members, &pb.Member{
+			Username:  member.Username,
+			Role:      pb.OrganizationRole(member.Role),
+			CreatedAt: timestamppb.New(member.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (o *OrganizationsGRPC) AddMember(ctx context.Context, request *pb.MemberRequest) (*pb.MemberResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.organizationsService.AddMember(
+		userID,
+		request.GetOrganizationId(),
+		request.GetUsername(),
+		models.OrganizationRole(request.GetRole()),
+	)
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot add member")
+	}
+
+	return &pb.MemberResponse{}, nil
+}
+
+func (o *OrganizationsGRPC) UpdateMemberRole(ctx context.Context, request *pb.MemberRequest) (*pb.MemberResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.organizationsService.UpdateMemberRole(
+		userID,
+		request.GetOrganizationId(),
+		request.GetUsername(),
+		models.OrganizationRole(request.GetRole()),
+	)
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot update member role")
+	}
+
+	return &pb.MemberResponse{}, nil
+}
+
+func (o *OrganizationsGRPC) RemoveMember(ctx context.Context, request *pb.MemberRequest) (*pb.MemberResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.organizationsService.RemoveMember(userID, request.GetOrganizationId(), request.GetUsername())
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot remove member")
+	}
+
+	return &pb.MemberResponse{}, nil
+}
+
+func (o *OrganizationsGRPC) CreateCollection(ctx context.Context, request *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := o.organizationsService.CreateCollection(userID, request.GetOrganizationId(), request.GetName())
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot create collection")
+	}
+
+	return collectionToPB(*collection), nil
+}
+
+func (o *OrganizationsGRPC) ListCollections(ctx context.Context, request *pb.OrganizationRequest) (*pb.ListCollectionsResponse, error) {
+	userID, err := userIDFromContext(ctx, o.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := o.organizationsService.ListCollections(userID, request.GetOrganizationId())
+	if err != nil {
+		return nil, organizationErrorToStatus(err, "cannot list collections")
+	}
+
+	response := &pb.ListCollectionsResponse{Collections: make([]*pb.Collection, 0, len(collections))}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, collectionToPB(collection))
+	}
+
+	return response, nil
+}
+
+func organizationToPB(organization models.Organization) *pb.Organization {
+	return &pb.Organization{
+		Id:        organization.ID,
+		Name:      organization.Name,
+		Role:      pb.OrganizationRole(organization.Role),
+		CreatedAt: timestamppb.New(organization.CreatedAt),
+	}
+}
+
+func collectionToPB(collection models.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:             collection.ID,
+		OrganizationId: collection.OrganizationID,
+		Name:           collection.Name,
+		CreatedAt:      timestamppb.New(collection.CreatedAt),
+	}
+}
+
+// organizationErrorToStatus converts errors of organizations to grpc status with matching code.
+func organizationErrorToStatus(err error, msg string) error {
+	var userNotFoundErr *storage.UserNotFoundError
+	if errors.As(err, &userNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var notUniqueOrganizationErr *storage.NotUniqueOrganizationError
+	if errors.As(err, &notUniqueOrganizationErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	var notUniqueCollectionErr *storage.NotUniqueCollectionError
+	if errors.As(err, &notUniqueCollectionErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	var notUniqueMemberErr *storage.NotUniqueMemberError
+	if errors.As(err, &notUniqueMemberErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	var memberNotFoundErr *storage.MemberNotFoundError
+	if errors.As(err, &memberNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var lastOwnerErr *storage.LastOwnerError
+	if errors.As(err, &lastOwnerErr) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
@@ -0,0 +1,199 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PasswordsGRPC struct {
+	pb.UnimplementedPasswordsServer
+	passwordsService services.PasswordsManagerInterface
+	secretsService   services.SecretsManagerInterface
+	jwtManager       *services.JWTManager
+}
+
+func NewPasswordsServerService(
+	service services.PasswordsManagerInterface,
+	secretsService services.SecretsManagerInterface,
+	manager *services.JWTManager,
+) *PasswordsGRPC {
+	return &PasswordsGRPC{passwordsService: service, secretsService: secretsService, jwtManager: manager}
+}
+
+func (p *PasswordsGRPC) EstimatePasswordStrength(
+	_ context.Context,
+	request *pb.EstimatePasswordStrengthRequest,
+) (*pb.PasswordStrength, error) {
+	strength := p.passwordsService.EstimateStrength(request.GetPassword(), request.GetUserInputs()...)
+
+	return passwordStrengthToPB(strength), nil
+}
+
+func (p *PasswordsGRPC) GetPasswordPolicy(ctx context.Context, _ *pb.GetPasswordPolicyRequest) (*pb.PasswordPolicy, error) {
+	userID, err := userIDFromContext(ctx, p.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	minScore, err := p.passwordsService.GetMinScore(userID)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot get password policy")
+	}
+
+	return &pb.PasswordPolicy{MinScore: int32(minScore)}, nil
+}
+
+func (p *PasswordsGRPC) SetPasswordPolicy(ctx context.Context, request *pb.PasswordPolicy) (*pb.PasswordPolicy, error) {
+	userID, err := userIDFromContext(ctx, p.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.passwordsService.SetMinScore(userID, int(request.GetMinScore()))
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot set password policy")
+	}
+
+	return &pb.PasswordPolicy{MinScore: request.GetMinScore()}, nil
+}
+
+func passwordStrengthToPB(strength services.PasswordStrength) *pb.PasswordStrength {
+	return &pb.PasswordStrength{
+		Score:    int32(strength.Score),
+		Entropy:  strength.Entropy,
+		Warnings: strength.Warnings,
+	}
+}
+
+func (p *PasswordsGRPC) GeneratePassword(
+	_ context.Context,
+	request *pb.GeneratePasswordRequest,
+) (*pb.GeneratePasswordResponse, error) {
+	var password string
+	var err error
+	if passphrase := request.GetPassphrase(); passphrase != nil {
+		password, err = p.passwordsService.GeneratePassphrase(passphrasePolicyFromPB(passphrase))
+	} else {
+		password, err = p.passwordsService.GeneratePassword(generationPolicyFromPB(request.GetPassword()))
+	}
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot generate password")
+	}
+
+	return &pb.GeneratePasswordResponse{
+		Password: password,
+		Strength: passwordStrengthToPB(p.passwordsService.EstimateStrength(password)),
+	}, nil
+}
+
+// generationPolicyFromPB converts policy replacing zero values with defaults.
+func generationPolicyFromPB(request *pb.GenerationPolicy) services.GenerationPolicy {
+	policy := services.DefaultGenerationPolicy()
+	if request == nil {
+		return policy
+	}
+
+	if request.GetLength() != 0 {
+		policy.Length = int(request.GetLength())
+	}
+	if request.GetLower() || request.GetUpper() || request.GetDigits() || request.GetSymbols() {
+		policy.Lower = request.GetLower()
+		policy.Upper = request.GetUpper()
+		policy.Digits = request.GetDigits()
+		policy.Symbols = request.GetSymbols()
+	}
+	policy.MinLower = int(request.GetMinLower())
+	policy.MinUpper = int(request.GetMinUpper())
+	policy.MinDigits = int(request.GetMinDigits())
+	policy.MinSymbols = int(request.GetMinSymbols())
+	policy.ExcludeAmbiguous = request.GetExcludeAmbiguous()
+
+	return policy
+}
+
+// passphrasePolicyFromPB converts policy replacing zero values with defaults.
+func passphrasePolicyFromPB(request *pb.PassphrasePolicy) services.PassphrasePolicy {
+	policy := services.PassphrasePolicy{
+		Words:         int(request.GetWords()),
+		Divider:       request.GetDivider(),
+		Capitalize:    request.GetCapitalize(),
+		IncludeNumber: request.GetIncludeNumber(),
+	}
+	if policy.Words == 0 {
+		policy.Words = services.DefaultPassphraseWords
+	}
+	if policy.Divider == "" {
+		policy.Divider = services.DefaultPassphraseDivider
+	}
+
+	return policy
+}
+
+func (p *PasswordsGRPC) CheckBreached(ctx context.Context, request *pb.CheckBreachedRequest) (*pb.BreachCheck, error) {
+	password := request.GetPassword()
+	if secretName := request.GetSecretName(); secretName != "" {
+		userID, err := userIDFromContext(ctx, p.jwtManager)
+		if err != nil {
+			return nil, err
+		}
+
+		secret, _, err := p.secretsService.GetDecodedSecret(models.SecretMetadata{
+			Name:   secretName,
+			Type:   models.SecretTypePassword,
+			UserID: userID,
+		})
+		if err != nil {
+			return nil, secretErrorToStatus(err, "cannot get password")
+		}
+		passwordSecret, ok := secret.(*models.PasswordSecret)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "secret is not a password")
+		}
+		password = passwordSecret.Password
+	}
+
+	breach, err := checkBreached(p.passwordsService, password)
+	if errors.Is(err, services.ErrBreachIndexNotLoaded) {
+		return nil, status.Errorf(codes.FailedPrecondition, "server has no corpus of breached passwords")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot check password")
+	}
+
+	return breach, nil
+}
+
+func (p *PasswordsGRPC) CheckBreachedRange(_ context.Context, request *pb.BreachRangeRequest) (*pb.BreachRange, error) {
+	hashes, err := p.passwordsService.CheckBreachedRange(request.GetPrefix())
+	if errors.Is(err, services.ErrBreachIndexNotLoaded) {
+		return nil, status.Errorf(codes.FailedPrecondition, "server has no corpus of breached passwords")
+	}
+	if errors.Is(err, services.ErrInvalidHashPrefix) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot check password")
+	}
+
+	response := &pb.BreachRange{Hashes: make([]*pb.BreachedHash, 0, len(hashes))}
+	for _, hash := range hashes {
+		response.Hashes = append(response.Hashes, &pb.BreachedHash{Suffix: hash.Suffix, Count: int64(hash.Count)})
+	}
+
+	return response, nil
+}
+
+func checkBreached(service services.PasswordsManagerInterface, password string) (*pb.BreachCheck, error) {
+	count, err := service.CheckBreached(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BreachCheck{Breached: count > 0, Count: int64(count)}, nil
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SecretsGRPC) ListExpiring(ctx context.Context, request *pb.ListExpiringRequest) (*pb.ListExpiringResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.secretsService.ListExpiring(userID, collectionID, time.Duration(request.GetWithinDays())*day)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot list expiring secrets")
+	}
+
+	response := &pb.ListExpiringResponse{Secrets: make([]*pb.DueSecret, 0, len(secrets))}
+	for _, secret := range secrets {
+		response.Secrets = append(response.Secrets, &pb.DueSecret{
+			Metadata: secretMetadataToPB(secret.Metadata),
+			DueAt:    timestamppb.New(secret.DueAt),
+			Reason:   dueReasonToPB(secret.Reason),
+		})
+	}
+
+	return response, nil
+}
+
+func dueReasonToPB(reason models.DueReason) pb.DueReason {
+	switch reason {
+	case models.DueReasonExpiry:
+		return pb.DueReason_DUE_REASON_EXPIRY
+	case models.DueReasonRotation:
+		return pb.DueReason_DUE_REASON_ROTATION
+	}
+	return pb.DueReason_DUE_REASON_UNSPECIFIED
+}
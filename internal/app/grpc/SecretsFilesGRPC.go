@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SecretsGRPC) UploadFile(stream pb.Secrets_UploadFileServer) error {
+	userID, collectionID, err := s.getVault(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	request, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.Unknown, "cannot receive file info: %v", err)
+	}
+
+	info := request.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must contain file info")
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         info.GetName(),
+		Type:         models.SecretTypeBinary,
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	applyAttributes(&secretMetadata, info.GetAttributes())
+
+	if info.GetClientEncrypted() {
+		reader := &uploadReader{stream: stream}
+		err = s.secretsService.SaveEncryptedFile(secretMetadata, reader.ReadChunk, reader.EncryptedInfo)
+		if err != nil {
+			return secretErrorToStatus(err, "cannot save file")
+		}
+
+		return stream.SendAndClose(&pb.FileInfo{Name: secretMetadata.Name, ClientEncrypted: true})
+	}
+
+	file, err := s.secretsService.SaveFile(secretMetadata, info.GetFileName(), &uploadReader{stream: stream})
+	if err != nil {
+		return secretErrorToStatus(err, "cannot save file")
+	}
+
+	return stream.SendAndClose(fileInfoToPB(secretMetadata.Name, file))
+}
+
+func (s *SecretsGRPC) DownloadFile(request *pb.GetSecretRequest, stream pb.Secrets_DownloadFileServer) error {
+	userID, collectionID, err := s.getVault(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretTypeBinary,
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	file, found, err := s.secretsService.GetFileInfo(secretMetadata)
+	if err != nil {
+		return secretErrorToStatus(err, "cannot get file")
+	}
+
+	response := &pb.DownloadFileResponse{}
+	switch info := file.(type) {
+	case *models.BinarySecret:
+		response.Data = &pb.DownloadFileResponse_Info{Info: fileInfoToPB(found.Name, info)}
+	case *models.EncryptedSecret:
+		response.Data = &pb.DownloadFileResponse_EncryptedInfo{EncryptedInfo: info.Data}
+	default:
+		return status.Errorf(codes.Internal, "cannot get file: unexpected secret %T", file)
+	}
+
+	err = stream.Send(response)
+	if err != nil {
+		return err
+	}
+
+	secretMetadata.ClientEncrypted = found.ClientEncrypted
+
+	err = s.secretsService.ReadFile(secretMetadata, &downloadWriter{stream: stream})
+	if err != nil {
+		return secretErrorToStatus(err, "cannot read file")
+	}
+
+	return nil
+}
+
+func fileInfoToPB(name string, file *models.BinarySecret) *pb.FileInfo {
+	return &pb.FileInfo{
+		Name:     name,
+		FileName: file.FileName,
+		Size:     file.Size,
+		Checksum: file.Checksum,
+	}
+}
+
+// uploadReader reads file content from chunks of upload stream.
+type uploadReader struct {
+	stream        pb.Secrets_UploadFileServer
+	chunk         []byte
+	encryptedInfo []byte
+}
+
+// ReadChunk returns the next chunk of client-encrypted upload as it is sent.
+// Encrypted file info is remembered, it must be the last message of the stream.
+func (r *uploadReader) ReadChunk() ([]byte, error) {
+	request, err := r.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if r.encryptedInfo != nil || request.GetInfo() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "encrypted file info must be the last message and file info must be sent only once")
+	}
+
+	if encryptedInfo := request.GetEncryptedInfo(); encryptedInfo != nil {
+		r.encryptedInfo = encryptedInfo
+		return r.ReadChunk()
+	}
+
+	return request.GetChunk(), nil
+}
+
+// EncryptedInfo returns encrypted file info of client-encrypted upload after all chunks are read.
+func (r *uploadReader) EncryptedInfo() []byte {
+	return r.encryptedInfo
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		request, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if request.GetInfo() != nil || request.GetEncryptedInfo() != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "file info must be sent only once")
+		}
+		r.chunk = request.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
+
+// downloadWriter sends written file content as chunks of download stream.
+type downloadWriter struct {
+	stream pb.Secrets_DownloadFileServer
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	err := w.stream.Send(&pb.DownloadFileResponse{
+		Data: &pb.DownloadFileResponse_Chunk{Chunk: p},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
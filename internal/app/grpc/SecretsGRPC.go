@@ -2,21 +2,63 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/proto"
 	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
 	"github.com/belamov/ypgo-password-manager/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SecretsGRPC struct {
 	pb.UnimplementedSecretsServer
-	secretsService services.SecretsManagerInterface
-	jwtManager     *services.JWTManager
+	secretsService   services.SecretsManagerInterface
+	passwordsService services.PasswordsManagerInterface
+	jwtManager       *services.JWTManager
 }
 
 func (s *SecretsGRPC) getUserId(ctx context.Context) (int, error) {
+	return userIDFromContext(ctx, s.jwtManager)
+}
+
+// getVault returns id of the user and id of organization collection that request works with.
+func (s *SecretsGRPC) getVault(ctx context.Context) (int, int64, error) {
+	return vaultFromContext(ctx, s.jwtManager)
+}
+
+// vaultFromContext returns id of the user and id of organization collection that request works with.
+// Zero collection id means personal vault of the user. With emergency access the user is the owner of the vault.
+// Access to collection and emergency access are checked by AuthInterceptor.
+func vaultFromContext(ctx context.Context, jwtManager *services.JWTManager) (int, int64, error) {
+	userID, err := userIDFromContext(ctx, jwtManager)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ownerID, err := proto.EmergencyOwnerIDFromContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ownerID != 0 {
+		return ownerID, 0, nil
+	}
+
+	collectionID, err := proto.CollectionIDFromContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return userID, collectionID, nil
+}
+
+// userIDFromContext returns id of the user whose access token is in request metadata.
+func userIDFromContext(ctx context.Context, jwtManager *services.JWTManager) (int, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return 0, status.Errorf(codes.Unauthenticated, "metadata is not provided")
@@ -28,166 +70,494 @@ func (s *SecretsGRPC) getUserId(ctx context.Context) (int, error) {
 	}
 
 	accessToken := values[0]
-	claims, err := s.jwtManager.Verify(accessToken)
+	claims, err := jwtManager.Verify(accessToken)
 	if err != nil {
 		return 0, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
 	}
 
 	return claims.Id, nil
 }
-func (s *SecretsGRPC) SavePassword(ctx context.Context, request *pb.SavePasswordRequest) (*pb.Empty, error) {
-	userID, err := s.getUserId(ctx)
+
+func (s *SecretsGRPC) PutSecret(ctx context.Context, request *pb.PutSecretRequest) (*pb.PutSecretResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	secretPassword := &models.PasswordSecret{
-		Login:    request.GetLogin(),
-		Password: request.GetPassword(),
+	secret, ok := secretFromRequest(request)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported secret payload")
 	}
+
 	secretMetadata := models.SecretMetadata{
-		Name:   request.GetName(),
-		Type:   models.SecretTypePassword,
-		UserID: userID,
+		Name:         request.GetName(),
+		Type:         secret.Type(),
+		UserID:       userID,
+		Version:      request.GetVersion(),
+		CollectionID: collectionID,
 	}
+	applyAttributes(&secretMetadata, request.GetAttributes())
+	selectShared(&secretMetadata, request.GetSharedId())
 
-	secretData, err := secretPassword.ToBinary()
+	version, err := s.secretsService.PutSecret(secret, secretMetadata)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot encode secret: %v", err)
+		return nil, secretErrorToStatus(err, "cannot save secret")
 	}
 
-	err = s.secretsService.SaveSecret(secretData, secretMetadata)
+	response := &pb.PutSecretResponse{Version: version}
+	if password, ok := secret.(*models.PasswordSecret); ok {
+		response.Strength = passwordStrengthToPB(
+			s.passwordsService.EstimateStrength(password.Password, password.Login, secretMetadata.Name),
+		)
+		// secret is already saved, so failed check only leaves breach unset
+		response.Breach, _ = checkBreached(s.passwordsService, password.Password)
+	}
 
-	return &pb.Empty{}, err
+	return response, nil
 }
 
-func (s *SecretsGRPC) GetPassword(ctx context.Context, request *pb.GetSecretRequest) (*pb.PasswordResponse, error) {
-	userID, err := s.getUserId(ctx)
+func (s *SecretsGRPC) GetSecret(ctx context.Context, request *pb.GetSecretRequest) (*pb.SecretResponse, error) {
+	return s.getSecret(ctx, request, s.secretsService.GetDecodedSecret)
+}
+
+func (s *SecretsGRPC) RevealSecret(ctx context.Context, request *pb.GetSecretRequest) (*pb.SecretResponse, error) {
+	return s.getSecret(ctx, request, s.secretsService.RevealSecret)
+}
+
+// getSecret gets secret with one of service methods and converts it to response.
+func (s *SecretsGRPC) getSecret(
+	ctx context.Context,
+	request *pb.GetSecretRequest,
+	get func(models.SecretMetadata) (models.Secret, models.SecretMetadata, error),
+) (*pb.SecretResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	secretMetadata := models.SecretMetadata{
-		Name:   request.GetName(),
-		Type:   models.SecretTypePassword,
-		UserID: userID,
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
 	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	secret, secretMetadata, err := get(secretMetadata)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot get secret")
+	}
+
+	payload, ok := secretToPayload(secret)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cannot encode secret of type %s", secret.Type())
+	}
+
+	response := &pb.SecretResponse{
+		Metadata: secretMetadataToPB(secretMetadata),
+		Payload:  payload,
+	}
+
+	return response, nil
+}
 
-	secret, err := s.secretsService.GetPassword(secretMetadata)
+func (s *SecretsGRPC) SavePassword(ctx context.Context, request *pb.SavePasswordRequest) (*pb.PutSecretResponse, error) {
+	return s.PutSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Password{Password: &pb.PasswordData{
+			Login:    request.GetLogin(),
+			Password: request.GetPassword(),
+			Urls:     request.GetUrls(),
+		}}},
+		Attributes: request.GetAttributes(),
+	})
+}
+
+func (s *SecretsGRPC) GetPassword(ctx context.Context, request *pb.GetSecretRequest) (*pb.PasswordResponse, error) {
+	secret, err := s.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: request.GetName(),
+		Type: pb.SecretType_SECRET_TYPE_PASSWORD,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	response := &pb.PasswordResponse{
-		Login:    secret.Login,
-		Password: secret.Password,
+		Login:    secret.GetPayload().GetPassword().GetLogin(),
+		Password: secret.GetPayload().GetPassword().GetPassword(),
+		Version:  secret.GetMetadata().GetVersion(),
+		Urls:     secret.GetPayload().GetPassword().GetUrls(),
 	}
 
 	return response, nil
 }
 
 func (s *SecretsGRPC) SaveCard(ctx context.Context, request *pb.SaveCardRequest) (*pb.Empty, error) {
-	userID, err := s.getUserId(ctx)
+	_, err := s.PutSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetCardName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Card{Card: &pb.CardData{
+			Number:     request.GetNumber(),
+			HolderName: request.GetHolderName(),
+			Date:       request.GetDate(),
+			Ccv:        request.GetCcv(),
+		}}},
+		Attributes: request.GetAttributes(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	secret := &models.CardSecret{
-		Number:     request.GetNumber(),
-		HolderName: request.GetHolderName(),
-		CCV:        request.GetCcv(),
-		Date:       request.GetDate(),
-	}
-	secretMetadata := models.SecretMetadata{
-		Name:   request.GetCardName(),
-		Type:   models.SecretTypeCard,
-		UserID: userID,
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) GetCard(ctx context.Context, request *pb.GetSecretRequest) (*pb.CardResponse, error) {
+	secret, err := s.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: request.GetName(),
+		Type: pb.SecretType_SECRET_TYPE_CARD,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	secretData, err := secret.ToBinary()
+	return cardResponse(secret), nil
+}
+
+func (s *SecretsGRPC) RevealCard(ctx context.Context, request *pb.GetSecretRequest) (*pb.CardResponse, error) {
+	secret, err := s.RevealSecret(ctx, &pb.GetSecretRequest{
+		Name: request.GetName(),
+		Type: pb.SecretType_SECRET_TYPE_CARD,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot encode secret: %v", err)
+		return nil, err
 	}
 
-	err = s.secretsService.SaveSecret(secretData, secretMetadata)
+	return cardResponse(secret), nil
+}
 
-	return &pb.Empty{}, err
+func cardResponse(secret *pb.SecretResponse) *pb.CardResponse {
+	card := secret.GetPayload().GetCard()
+	return &pb.CardResponse{
+		Number:     card.GetNumber(),
+		HolderName: card.GetHolderName(),
+		Date:       card.GetDate(),
+		Ccv:        card.GetCcv(),
+		Version:    secret.GetMetadata().GetVersion(),
+		Brand:      card.GetBrand(),
+		Last4:      card.GetLast4(),
+	}
 }
 
-func (s *SecretsGRPC) GetCard(ctx context.Context, request *pb.GetSecretRequest) (*pb.CardResponse, error) {
-	userID, err := s.getUserId(ctx)
+func (s *SecretsGRPC) SaveText(ctx context.Context, request *pb.SaveTextRequest) (*pb.Empty, error) {
+	_, err := s.PutSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Text{Text: &pb.TextData{
+			Text: request.GetText(),
+		}}},
+		Attributes: request.GetAttributes(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	secretMetadata := models.SecretMetadata{
-		Name:   request.GetName(),
-		Type:   models.SecretTypeCard,
-		UserID: userID,
-	}
+	return &pb.Empty{}, nil
+}
 
-	secret, err := s.secretsService.GetCard(secretMetadata)
+func (s *SecretsGRPC) GetText(ctx context.Context, request *pb.GetSecretRequest) (*pb.TextResponse, error) {
+	secret, err := s.GetSecret(ctx, &pb.GetSecretRequest{
+		Name: request.GetName(),
+		Type: pb.SecretType_SECRET_TYPE_TEXT,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := &pb.CardResponse{
-		Number:     secret.Number,
-		HolderName: secret.HolderName,
-		Date:       secret.Date,
-		Ccv:        secret.CCV,
+	response := &pb.TextResponse{
+		Text:    secret.GetPayload().GetText().GetText(),
+		Version: secret.GetMetadata().GetVersion(),
 	}
 
 	return response, nil
 }
 
-func (s *SecretsGRPC) SaveText(ctx context.Context, request *pb.SaveTextRequest) (*pb.Empty, error) {
-	userID, err := s.getUserId(ctx)
+func (s *SecretsGRPC) UpdatePassword(ctx context.Context, request *pb.UpdatePasswordRequest) (*pb.UpdateSecretResponse, error) {
+	return s.updateSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Password{Password: &pb.PasswordData{
+			Login:    request.GetLogin(),
+			Password: request.GetPassword(),
+			Urls:     request.GetUrls(),
+		}}},
+		Version:    request.GetVersion(),
+		Attributes: request.GetAttributes(),
+	})
+}
+
+func (s *SecretsGRPC) UpdateCard(ctx context.Context, request *pb.UpdateCardRequest) (*pb.UpdateSecretResponse, error) {
+	return s.updateSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetCardName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Card{Card: &pb.CardData{
+			Number:     request.GetNumber(),
+			HolderName: request.GetHolderName(),
+			Date:       request.GetDate(),
+			Ccv:        request.GetCcv(),
+		}}},
+		Version:    request.GetVersion(),
+		Attributes: request.GetAttributes(),
+	})
+}
+
+func (s *SecretsGRPC) UpdateText(ctx context.Context, request *pb.UpdateTextRequest) (*pb.UpdateSecretResponse, error) {
+	return s.updateSecret(ctx, &pb.PutSecretRequest{
+		Name: request.GetName(),
+		Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Text{Text: &pb.TextData{
+			Text: request.GetText(),
+		}}},
+		Version:    request.GetVersion(),
+		Attributes: request.GetAttributes(),
+	})
+}
+
+// updateSecret puts existing secret. Unlike PutSecret it never creates new secret.
+func (s *SecretsGRPC) updateSecret(ctx context.Context, request *pb.PutSecretRequest) (*pb.UpdateSecretResponse, error) {
+	if request.GetVersion() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "version of updated secret is required")
+	}
+
+	response, err := s.PutSecret(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSecretResponse{
+		Version:  response.GetVersion(),
+		Strength: response.GetStrength(),
+		Breach:   response.GetBreach(),
+	}, nil
+}
+
+func (s *SecretsGRPC) ListSecrets(ctx context.Context, request *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	secret := &models.TextSecret{
-		Text: request.GetText(),
+	filter := models.SecretsFilter{
+		NamePrefix:   request.GetNamePrefix(),
+		Cursor:       request.GetCursor(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		Limit:        int(request.GetLimit()),
+		Tags:         request.GetAttributes().GetTags(),
+		Labels:       request.GetAttributes().GetLabels(),
+		CollectionID: collectionID,
+	}
+
+	secrets, nextCursor, err := s.secretsService.ListSecrets(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list secrets: %v", err)
+	}
+
+	return secretsListToPB(secrets, nextCursor), nil
+}
+
+func (s *SecretsGRPC) DeleteSecret(ctx context.Context, request *pb.DeleteSecretRequest) (*pb.Empty, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
 	}
+
 	secretMetadata := models.SecretMetadata{
-		Name:   request.GetName(),
-		Type:   models.SecretTypeText,
-		UserID: userID,
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
 	}
 
-	secretData, err := secret.ToBinary()
+	err = s.secretsService.DeleteSecret(secretMetadata)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot encode secret: %v", err)
+		return nil, secretErrorToStatus(err, "cannot delete secret")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) ListTrash(ctx context.Context, request *pb.ListTrashRequest) (*pb.ListSecretsResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.secretsService.SaveSecret(secretData, secretMetadata)
+	filter := models.SecretsFilter{
+		Cursor:       request.GetCursor(),
+		UserID:       userID,
+		Limit:        int(request.GetLimit()),
+		CollectionID: collectionID,
+	}
 
-	return &pb.Empty{}, err
+	secrets, nextCursor, err := s.secretsService.ListTrash(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list trash: %v", err)
+	}
+
+	return secretsListToPB(secrets, nextCursor), nil
 }
 
-func (s *SecretsGRPC) GetText(ctx context.Context, request *pb.GetSecretRequest) (*pb.TextResponse, error) {
-	userID, err := s.getUserId(ctx)
+func (s *SecretsGRPC) RestoreSecret(ctx context.Context, request *pb.TrashedSecretRequest) (*pb.Empty, error) {
+	userID, collectionID, err := s.getVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	secretMetadata := models.SecretMetadata{
-		Name:   request.GetName(),
-		Type:   models.SecretTypeText,
-		UserID: userID,
+		ID:           request.GetId(),
+		UserID:       userID,
+		CollectionID: collectionID,
 	}
 
-	secret, err := s.secretsService.GetText(secretMetadata)
+	err = s.secretsService.RestoreSecret(secretMetadata)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot restore secret")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) PurgeSecret(ctx context.Context, request *pb.TrashedSecretRequest) (*pb.Empty, error) {
+	userID, collectionID, err := s.getVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &pb.TextResponse{Text: secret.Text}
+	secretMetadata := models.SecretMetadata{
+		ID:           request.GetId(),
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
 
-	return response, nil
+	err = s.secretsService.PurgeSecret(secretMetadata)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot purge secret")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// secretErrorToStatus converts storage errors to grpc status with matching code.
+func secretErrorToStatus(err error, msg string) error {
+	var notFoundErr *storage.SecretNotFoundError
+	if errors.As(err, &notFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var notUniqueErr *storage.NotUniqueSecretError
+	if errors.As(err, &notUniqueErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	var conflictErr *storage.SecretVersionConflictError
+	if errors.As(err, &conflictErr) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	if errors.Is(err, services.ErrUnknownSecretType) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	}
+
+	var validationErr *services.ValidationError
+	if errors.As(err, &validationErr) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	}
+
+	if errors.Is(err, services.ErrClientEncrypted) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	if errors.Is(err, services.ErrPermissionDenied) {
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	}
+
+	// data key of the secret is deleted, secret can't be decrypted anymore
+	var dataKeyNotFoundErr *storage.DataKeyNotFoundError
+	if errors.As(err, &dataKeyNotFoundErr) {
+		return status.Errorf(codes.DataLoss, "%s: %v", msg, err)
+	}
+
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// applyAttributes copies tags and labels from request to metadata.
+// Expiry date and rotation period are copied only if they are set or cleared in request.
+// Metadata stays unchanged if attributes are not set.
+func applyAttributes(metadata *models.SecretMetadata, attributes *pb.SecretAttributes) {
+	if attributes == nil {
+		return
+	}
+
+	metadata.Tags = make([]string, 0, len(attributes.GetTags()))
+	metadata.Tags = append(metadata.Tags, attributes.GetTags()...)
+
+	metadata.Labels = make(map[string]string, len(attributes.GetLabels()))
+	for key, value := range attributes.GetLabels() {
+		metadata.Labels[key] = value
+	}
+
+	switch {
+	case attributes.GetClearExpiresAt():
+		metadata.ExpiresAt = nil
+		metadata.ExpiresAtSet = true
+	case attributes.GetExpiresAt() != nil:
+		expiresAt := attributes.GetExpiresAt().AsTime()
+		metadata.ExpiresAt = &expiresAt
+		metadata.ExpiresAtSet = true
+	}
+	if attributes.RotateEveryDays != nil {
+		metadata.RotateEvery = time.Duration(attributes.GetRotateEveryDays()) * day
+		metadata.RotateEverySet = true
+	}
+}
+
+func secretsListToPB(secrets []models.SecretMetadata, nextCursor int64) *pb.ListSecretsResponse {
+	response := &pb.ListSecretsResponse{
+		Secrets:    make([]*pb.SecretMetadata, 0, len(secrets)),
+		NextCursor: nextCursor,
+	}
+	for _, secret := range secrets {
+		response.Secrets = append(response.Secrets, secretMetadataToPB(secret))
+	}
+
+	return response
+}
+
+func secretMetadataToPB(metadata models.SecretMetadata) *pb.SecretMetadata {
+	rotateEveryDays := int32(metadata.RotateEvery / day)
+	secret := &pb.SecretMetadata{
+		Id:              metadata.ID,
+		Name:            metadata.Name,
+		Type:            pb.SecretType(metadata.Type),
+		CreatedAt:       timestamppb.New(metadata.CreatedAt),
+		UpdatedAt:       timestamppb.New(metadata.UpdatedAt),
+		Version:         metadata.Version,
+		ClientEncrypted: metadata.ClientEncrypted,
+		Attributes: &pb.SecretAttributes{
+			Tags:            metadata.Tags,
+			Labels:          metadata.Labels,
+			RotateEveryDays: &rotateEveryDays,
+		},
+	}
+	if metadata.DeletedAt != nil {
+		secret.DeletedAt = timestamppb.New(*metadata.DeletedAt)
+	}
+	if metadata.ExpiresAt != nil {
+		secret.Attributes.ExpiresAt = timestamppb.New(*metadata.ExpiresAt)
+	}
+
+	return secret
 }
 
-func NewSecretsServerService(service services.SecretsManagerInterface, manager *services.JWTManager) *SecretsGRPC {
-	return &SecretsGRPC{secretsService: service, jwtManager: manager}
+func NewSecretsServerService(
+	service services.SecretsManagerInterface,
+	passwordsService services.PasswordsManagerInterface,
+	manager *services.JWTManager,
+) *SecretsGRPC {
+	return &SecretsGRPC{secretsService: service, passwordsService: passwordsService, jwtManager: manager}
 }
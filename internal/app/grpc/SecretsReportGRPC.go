@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const day = 24 * time.Hour
+
+func (s *SecretsGRPC) SecurityReport(ctx context.Context, request *pb.SecurityReportRequest) (*pb.SecurityReportResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.secretsService.SecurityReport(userID, collectionID, services.SecurityReportOptions{
+		MaxPasswordAge:    time.Duration(request.GetMaxPasswordAgeDays()) * day,
+		CardExpiryWarning: time.Duration(request.GetCardExpiryWarningDays()) * day,
+		WeakPasswordScore: int(request.GetMinScore()),
+	})
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot build security report")
+	}
+
+	response := &pb.SecurityReportResponse{}
+	for _, names := range report.ReusedPasswords {
+		response.ReusedPasswords = append(response.ReusedPasswords, &pb.ReusedPasswords{Names: names})
+	}
+	for _, weak := range report.WeakPasswords {
+		response.WeakPasswords = append(response.WeakPasswords, &pb.WeakPasswordReport{
+			Name:     weak.Name,
+			Strength: passwordStrengthToPB(weak.Strength),
+		})
+	}
+	for _, old := range report.OldPasswords {
+		response.OldPasswords = append(response.OldPasswords, &pb.OldPasswordReport{
+			Name:      old.Name,
+			ChangedAt: timestamppb.New(old.ChangedAt),
+		})
+	}
+	response.ExpiredCards = expiringCardsToPB(report.ExpiredCards)
+	response.ExpiringCards = expiringCardsToPB(report.ExpiringCards)
+
+	return response, nil
+}
+
+func expiringCardsToPB(cards []services.ExpiringCard) []*pb.ExpiringCardReport {
+	response := make([]*pb.ExpiringCardReport, 0, len(cards))
+	for _, card := range cards {
+		response = append(response, &pb.ExpiringCardReport{
+			Name:      card.Name,
+			Last4:     card.Last4,
+			ExpiresAt: timestamppb.New(card.ExpiresAt),
+		})
+	}
+
+	return response
+}
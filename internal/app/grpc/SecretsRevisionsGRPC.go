@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SecretsGRPC) ListRevisions(ctx context.Context, request *pb.ListRevisionsRequest) (*pb.ListRevisionsResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	revisions, err := s.secretsService.ListRevisions(secretMetadata)
+	if err != nil {
+		return nil, revisionErrorToStatus(err, "cannot list revisions")
+	}
+
+	response := &pb.ListRevisionsResponse{
+		Revisions: make([]*pb.Revision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, revisionToPB(revision))
+	}
+
+	return response, nil
+}
+
+func (s *SecretsGRPC) GetRevision(ctx context.Context, request *pb.RevisionRequest) (*pb.RevisionResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	secret, revision, err := s.secretsService.GetRevision(secretMetadata, request.GetVersion())
+	if err != nil {
+		return nil, revisionErrorToStatus(err, "cannot get revision")
+	}
+
+	response := &pb.RevisionResponse{Revision: revisionToPB(revision)}
+	switch secret := secret.(type) {
+	case *models.PasswordSecret:
+		response.Secret = &pb.RevisionResponse_Password{Password: &pb.PasswordResponse{
+			Login:    secret.Login,
+			Password: secret.Password,
+			Version:  revision.Version,
+			Urls:     passwordToPB(secret).Urls,
+		}}
+	case *models.CardSecret:
+		response.Secret = &pb.RevisionResponse_Card{Card: &pb.CardResponse{
+			Number:     secret.Number,
+			HolderName: secret.HolderName,
+			Date:       secret.Date,
+			Ccv:        secret.CCV,
+			Version:    revision.Version,
+			Brand:      secret.Brand,
+			Last4:      secret.Last4(),
+		}}
+	case *models.TextSecret:
+		response.Secret = &pb.RevisionResponse_Text{Text: &pb.TextResponse{
+			Text:    secret.Text,
+			Version: revision.Version,
+		}}
+	case *models.EncryptedSecret:
+		response.Secret = &pb.RevisionResponse_Encrypted{Encrypted: secret.Data}
+	default:
+		return nil, status.Errorf(codes.Internal, "unexpected secret type %T", secret)
+	}
+
+	return response, nil
+}
+
+func (s *SecretsGRPC) RestoreRevision(ctx context.Context, request *pb.RevisionRequest) (*pb.UpdateSecretResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	version, err := s.secretsService.RestoreRevision(secretMetadata, request.GetVersion())
+	if err != nil {
+		return nil, revisionErrorToStatus(err, "cannot restore revision")
+	}
+
+	return &pb.UpdateSecretResponse{Version: version}, nil
+}
+
+func revisionToPB(revision models.SecretRevision) *pb.Revision {
+	return &pb.Revision{
+		Version:   revision.Version,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+// revisionErrorToStatus converts errors of revisions to grpc status with matching code.
+func revisionErrorToStatus(err error, msg string) error {
+	var revisionNotFoundErr *storage.RevisionNotFoundError
+	if errors.As(err, &revisionNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SecretsGRPC) CreateShareLink(ctx context.Context, request *pb.CreateShareLinkRequest) (*pb.ShareLink, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretType(request.GetType()),
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	selectShared(&secretMetadata, request.GetSharedId())
+
+	token, link, err := s.secretsService.CreateShareLink(
+		secretMetadata,
+		int(request.GetMaxRedemptions()),
+		time.Duration(request.GetTtlHours())*time.Hour,
+	)
+	if err != nil {
+		return nil, shareLinkErrorToStatus(err, "cannot create share link")
+	}
+
+	return &pb.ShareLink{
+		Token:          token,
+		MaxRedemptions: int32(link.MaxRedemptions),
+		ExpiresAt:      timestamppb.New(link.ExpiresAt),
+	}, nil
+}
+
+// RedeemShareLink is called without authentication, the token of the link is the only credential.
+func (s *SecretsGRPC) RedeemShareLink(_ context.Context, request *pb.RedeemShareLinkRequest) (*pb.RedeemShareLinkResponse, error) {
+	secret, link, err := s.secretsService.RedeemShareLink(request.GetToken())
+	if err != nil {
+		return nil, shareLinkErrorToStatus(err, "cannot redeem share link")
+	}
+
+	payload, ok := secretToPayload(secret)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cannot encode secret of type %s", secret.Type())
+	}
+
+	return &pb.RedeemShareLinkResponse{
+		Name:            link.SecretName,
+		Payload:         payload,
+		RedemptionsLeft: int32(link.MaxRedemptions - link.Redemptions),
+		ExpiresAt:       timestamppb.New(link.ExpiresAt),
+	}, nil
+}
+
+// shareLinkErrorToStatus converts errors of share links to grpc status with matching code.
+func shareLinkErrorToStatus(err error, msg string) error {
+	var shareLinkNotFoundErr *storage.ShareLinkNotFoundError
+	if errors.As(err, &shareLinkNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SecretsGRPC) ShareSecret(ctx context.Context, request *pb.ShareSecretRequest) (*pb.Empty, error) {
+	userID, err := s.getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:   request.GetName(),
+		Type:   models.SecretType(request.GetType()),
+		UserID: userID,
+	}
+
+	err = s.secretsService.ShareSecret(secretMetadata, request.GetUsername(), models.SharePermission(request.GetPermission()))
+	if err != nil {
+		return nil, shareErrorToStatus(err, "cannot share secret")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) RevokeShare(ctx context.Context, request *pb.RevokeShareRequest) (*pb.Empty, error) {
+	userID, err := s.getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:   request.GetName(),
+		Type:   models.SecretType(request.GetType()),
+		UserID: userID,
+	}
+
+	err = s.secretsService.RevokeShare(secretMetadata, request.GetUsername())
+	if err != nil {
+		return nil, shareErrorToStatus(err, "cannot revoke share")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) ListShares(ctx context.Context, request *pb.ListSharesRequest) (*pb.ListSharesResponse, error) {
+	userID, err := s.getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:   request.GetName(),
+		Type:   models.SecretType(request.GetType()),
+		UserID: userID,
+	}
+
+	shares, err := s.secretsService.ListShares(secretMetadata)
+	if err != nil {
+		return nil, shareErrorToStatus(err, "cannot list shares")
+	}
+
+	response := &pb.ListSharesResponse{Shares: make([]*pb.Share, 0, len(shares))}
+	for _, share := range shares {
+		response.Shares = append(response.Shares, &pb.Share{
+			Username:   share.Username,
+			Permission: pb.SharePermission(share.Permission),
+			CreatedAt:  timestamppb.New(share.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *SecretsGRPC) ListSharedWithMe(ctx context.Context, _ *pb.Empty) (*pb.ListSharedWithMeResponse, error) {
+	userID, err := s.getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.secretsService.ListSharedWithMe(userID)
+	if err != nil {
+		return nil, shareErrorToStatus(err, "cannot list shared secrets")
+	}
+
+	response := &pb.ListSharedWithMeResponse{Secrets: make([]*pb.SharedSecret, 0, len(secrets))}
+	for _, secret := range secrets {
+		response.Secrets = append(response.Secrets, &pb.SharedSecret{
+			Metadata:   secretMetadataToPB(secret.Metadata),
+			Owner:      secret.OwnerName,
+			Permission: pb.SharePermission(secret.Permission),
+		})
+	}
+
+	return response, nil
+}
+
+// selectShared makes metadata select secret with sharedID that is shared with the user
+// instead of own secret of the user. Zero sharedID keeps metadata unchanged.
+func selectShared(metadata *models.SecretMetadata, sharedID int64) {
+	if sharedID == 0 {
+		return
+	}
+	metadata.ID = sharedID
+	metadata.Shared = true
+}
+
+// shareErrorToStatus converts errors of sharing secrets to grpc status with matching code.
+func shareErrorToStatus(err error, msg string) error {
+	var userNotFoundErr *storage.UserNotFoundError
+	if errors.As(err, &userNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var shareNotFoundErr *storage.ShareNotFoundError
+	if errors.As(err, &shareNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var shareWithOwnerErr *storage.ShareWithOwnerError
+	if errors.As(err, &shareWithOwnerErr) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
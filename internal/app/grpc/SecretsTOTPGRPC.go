@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"math"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+)
+
+func (s *SecretsGRPC) ImportTOTP(ctx context.Context, request *pb.ImportTOTPRequest) (*pb.PutSecretResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := services.ParseOTPAuthURI(request.GetUri())
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot import totp secret")
+	}
+
+	secretMetadata := models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretTypeTOTP,
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	applyAttributes(&secretMetadata, request.GetAttributes())
+
+	version, err := s.secretsService.PutSecret(secret, secretMetadata)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot import totp secret")
+	}
+
+	return &pb.PutSecretResponse{Version: version}, nil
+}
+
+func (s *SecretsGRPC) GetTOTPCode(ctx context.Context, request *pb.GetTOTPCodeRequest) (*pb.TOTPCodeResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	code, remaining, err := s.secretsService.GetTOTPCode(models.SecretMetadata{
+		Name:         request.GetName(),
+		Type:         models.SecretTypeTOTP,
+		UserID:       userID,
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot get totp code")
+	}
+
+	return &pb.TOTPCodeResponse{
+		Code:             code,
+		SecondsRemaining: int32(math.Ceil(remaining.Seconds())),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+)
+
+func (s *SecretsGRPC) FindByURL(ctx context.Context, request *pb.FindByURLRequest) (*pb.FindByURLResponse, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.secretsService.FindByURL(userID, collectionID, request.GetUrl())
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot find passwords")
+	}
+
+	response := &pb.FindByURLResponse{Credentials: make([]*pb.Credential, 0, len(matches))}
+	for _, match := range matches {
+		response.Credentials = append(response.Credentials, &pb.Credential{
+			Metadata: secretMetadataToPB(match.Metadata),
+			Password: passwordToPB(match.Secret),
+		})
+	}
+
+	return response, nil
+}
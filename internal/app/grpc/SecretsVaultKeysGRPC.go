@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+)
+
+func (s *SecretsGRPC) RotateVaultKey(ctx context.Context, _ *pb.Empty) (*pb.Empty, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.secretsService.RotateVaultKey(userID, collectionID)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot rotate vault key")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *SecretsGRPC) DeleteVaultKey(ctx context.Context, _ *pb.Empty) (*pb.Empty, error) {
+	userID, collectionID, err := s.getVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.secretsService.DeleteVaultKey(userID, collectionID)
+	if err != nil {
+		return nil, secretErrorToStatus(err, "cannot delete vault key")
+	}
+
+	return &pb.Empty{}, nil
+}
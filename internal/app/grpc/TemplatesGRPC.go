@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TemplatesGRPC struct {
+	pb.UnimplementedTemplatesServer
+	templatesService services.TemplatesManagerInterface
+	jwtManager       *services.JWTManager
+}
+
+func NewTemplatesServerService(service services.TemplatesManagerInterface, manager *services.JWTManager) *TemplatesGRPC {
+	return &TemplatesGRPC{templatesService: service, jwtManager: manager}
+}
+
+func (t *TemplatesGRPC) CreateTemplate(ctx context.Context, request *pb.TemplateRequest) (*pb.Template, error) {
+	userID, err := userIDFromContext(ctx, t.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := t.templatesService.CreateTemplate(templateFromPB(userID, request))
+	if err != nil {
+		return nil, templateErrorToStatus(err, "cannot create template")
+	}
+
+	return templateToPB(template), nil
+}
+
+func (t *TemplatesGRPC) UpdateTemplate(ctx context.Context, request *pb.TemplateRequest) (*pb.Template, error) {
+	userID, err := userIDFromContext(ctx, t.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := t.templatesService.UpdateTemplate(templateFromPB(userID, request))
+	if err != nil {
+		return nil, templateErrorToStatus(err, "cannot update template")
+	}
+
+	return templateToPB(template), nil
+}
+
+func (t *TemplatesGRPC) GetTemplate(ctx context.Context, request *pb.TemplateIDRequest) (*pb.Template, error) {
+	userID, err := userIDFromContext(ctx, t.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := t.templatesService.GetTemplate(userID, request.GetId())
+	if err != nil {
+		return nil, templateErrorToStatus(err, "cannot get template")
+	}
+
+	return templateToPB(template), nil
+}
+
+func (t *TemplatesGRPC) ListTemplates(ctx context.Context, _ *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	userID, err := userIDFromContext(ctx, t.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := t.templatesService.ListTemplates(userID)
+	if err != nil {
+		return nil, templateErrorToStatus(err, "cannot list templates")
+	}
+
+	response := &pb.ListTemplatesResponse{
+		Templates: make([]*pb.Template, 0, len(templates)),
+	}
+	for i := range templates {
+		response.Templates = append(response.Templates, templateToPB(&templates[i]))
+	}
+
+	return response, nil
+}
+
+func (t *TemplatesGRPC) DeleteTemplate(ctx context.Context, request *pb.TemplateIDRequest) (*pb.DeleteTemplateResponse, error) {
+	userID, err := userIDFromContext(ctx, t.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.templatesService.DeleteTemplate(userID, request.GetId())
+	if err != nil {
+		return nil, templateErrorToStatus(err, "cannot delete template")
+	}
+
+	return &pb.DeleteTemplateResponse{}, nil
+}
+
+func templateFromPB(userID int, request *pb.TemplateRequest) models.SecretTemplate {
+	template := models.SecretTemplate{
+		ID:     request.GetId(),
+		UserID: userID,
+		Name:   request.GetName(),
+		Fields: make([]models.TemplateField, 0, len(request.GetFields())),
+	}
+	for _, field := range request.GetFields() {
+		template.Fields = append(template.Fields, models.TemplateField{
+			Name:     field.GetName(),
+			Kind:     models.FieldKind(field.GetKind()),
+			Required: field.GetRequired(),
+		})
+	}
+
+	return template
+}
+
+func templateToPB(template *models.SecretTemplate) *pb.Template {
+	response := &pb.Template{
+		Id:        template.ID,
+		Name:      template.Name,
+		Fields:    make([]*pb.TemplateField, 0, len(template.Fields)),
+		CreatedAt: timestamppb.New(template.CreatedAt),
+		UpdatedAt: timestamppb.New(template.UpdatedAt),
+	}
+	for _, field := range template.Fields {
+		response.Fields = append(response.Fields, &pb.TemplateField{
+			Name:     field.Name,
+			Kind:     pb.FieldKind(field.Kind),
+			Required: field.Required,
+		})
+	}
+
+	return response
+}
+
+// templateErrorToStatus converts errors of templates to grpc status with matching code.
+func templateErrorToStatus(err error, msg string) error {
+	var templateNotFoundErr *storage.TemplateNotFoundError
+	if errors.As(err, &templateNotFoundErr) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+
+	var notUniqueTemplateErr *storage.NotUniqueTemplateError
+	if errors.As(err, &notUniqueTemplateErr) {
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+
+	return secretErrorToStatus(err, msg)
+}
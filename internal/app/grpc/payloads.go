@@ -0,0 +1,248 @@
+package grpc
+
+import (
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/pb"
+)
+
+// payloadCodec converts secrets of one type between protobuf payloads and models.
+type payloadCodec struct {
+	// fromPB returns nil if payload does not contain secret of this type
+	fromPB func(payload *pb.SecretPayload) models.Secret
+	toPB   func(secret models.Secret) *pb.SecretPayload
+}
+
+// payloadCodecs maps secret types to their codecs. Secret type that is registered here and in
+// models registry is supported by PutSecret and GetSecret without any other changes.
+var payloadCodecs = map[models.SecretType]payloadCodec{ //nolint:gochecknoglobals
+	models.SecretTypePassword: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetPassword()
+			if data == nil {
+				return nil
+			}
+			return &models.PasswordSecret{
+				Login:    data.GetLogin(),
+				Password: data.GetPassword(),
+				URLs:     passwordURLsFromPB(data.GetUrls()),
+			}
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			password, ok := secret.(*models.PasswordSecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_Password{Password: passwordToPB(password)}}
+		},
+	},
+	models.SecretTypeCard: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetCard()
+			if data == nil {
+				return nil
+			}
+			return &models.CardSecret{
+				Number:     data.GetNumber(),
+				HolderName: data.GetHolderName(),
+				CCV:        data.GetCcv(),
+				Date:       data.GetDate(),
+			}
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			card, ok := secret.(*models.CardSecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_Card{Card: &pb.CardData{
+				Number:     card.Number,
+				HolderName: card.HolderName,
+				Date:       card.Date,
+				Ccv:        card.CCV,
+				Brand:      card.Brand,
+				Last4:      card.Last4(),
+			}}}
+		},
+	},
+	models.SecretTypeText: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetText()
+			if data == nil {
+				return nil
+			}
+			return &models.TextSecret{Text: data.GetText()}
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			text, ok := secret.(*models.TextSecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_Text{Text: &pb.TextData{
+				Text: text.Text,
+			}}}
+		},
+	},
+	models.SecretTypeCustom: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetCustom()
+			if data == nil {
+				return nil
+			}
+			return &models.CustomSecret{
+				TemplateID: data.GetTemplateId(),
+				Fields:     data.GetFields(),
+			}
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			custom, ok := secret.(*models.CustomSecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_Custom{Custom: &pb.CustomData{
+				TemplateId: custom.TemplateID,
+				Fields:     custom.Fields,
+			}}}
+		},
+	},
+	models.SecretTypeTOTP: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetTotp()
+			if data == nil {
+				return nil
+			}
+			secret := &models.TOTPSecret{
+				Secret:      data.GetSecret(),
+				Issuer:      data.GetIssuer(),
+				AccountName: data.GetAccountName(),
+				Algorithm:   models.TOTPAlgorithm(data.GetAlgorithm()),
+				Digits:      int(data.GetDigits()),
+				Period:      int(data.GetPeriod()),
+			}
+			if secret.Algorithm == 0 {
+				secret.Algorithm = models.DefaultTOTPAlgorithm
+			}
+			if secret.Digits == 0 {
+				secret.Digits = models.DefaultTOTPDigits
+			}
+			if secret.Period == 0 {
+				secret.Period = models.DefaultTOTPPeriod
+			}
+			return secret
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			totp, ok := secret.(*models.TOTPSecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_Totp{Totp: &pb.TOTPData{
+				Secret:      totp.Secret,
+				Issuer:      totp.Issuer,
+				AccountName: totp.AccountName,
+				Algorithm:   pb.TOTPAlgorithm(totp.Algorithm),
+				Digits:      int32(totp.Digits),
+				Period:      int32(totp.Period),
+			}}}
+		},
+	},
+	models.SecretTypeSSHKey: {
+		fromPB: func(payload *pb.SecretPayload) models.Secret {
+			data := payload.GetSshKey()
+			if data == nil {
+				return nil
+			}
+			return &models.SSHKeySecret{
+				PrivateKey: data.GetPrivateKey(),
+				Passphrase: data.GetPassphrase(),
+				Comment:    data.GetComment(),
+			}
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			key, ok := secret.(*models.SSHKeySecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_SshKey{SshKey: &pb.SSHKeyData{
+				PrivateKey:  key.PrivateKey,
+				Passphrase:  key.Passphrase,
+				Comment:     key.Comment,
+				PublicKey:   key.PublicKey,
+				Fingerprint: key.Fingerprint,
+				KeyType:     key.KeyType,
+			}}}
+		},
+	},
+	models.SecretTypeBinary: {
+		// files can be saved only with UploadFile
+		fromPB: func(_ *pb.SecretPayload) models.Secret {
+			return nil
+		},
+		toPB: func(secret models.Secret) *pb.SecretPayload {
+			file, ok := secret.(*models.BinarySecret)
+			if !ok {
+				return nil
+			}
+			return &pb.SecretPayload{Payload: &pb.SecretPayload_File{File: &pb.FileInfo{
+				FileName: file.FileName,
+				Size:     file.Size,
+				Checksum: file.Checksum,
+			}}}
+		},
+	},
+}
+
+// secretFromRequest converts payload of the request to secret.
+// Encrypted payload becomes models.EncryptedSecret of the type from the request.
+func secretFromRequest(request *pb.PutSecretRequest) (models.Secret, bool) {
+	if encrypted, ok := request.GetPayload().GetPayload().(*pb.SecretPayload_Encrypted); ok {
+		return &models.EncryptedSecret{Data: encrypted.Encrypted, SecretType: models.SecretType(request.GetType())}, true
+	}
+
+	return secretFromPayload(request.GetPayload())
+}
+
+// secretFromPayload finds codec that accepts payload and converts payload to secret.
+func secretFromPayload(payload *pb.SecretPayload) (models.Secret, bool) {
+	for _, codec := range payloadCodecs {
+		if secret := codec.fromPB(payload); secret != nil {
+			return secret, true
+		}
+	}
+
+	return nil, false
+}
+
+// secretToPayload converts secret to payload with codec of its type.
+func secretToPayload(secret models.Secret) (*pb.SecretPayload, bool) {
+	if encrypted, ok := secret.(*models.EncryptedSecret); ok {
+		return &pb.SecretPayload{Payload: &pb.SecretPayload_Encrypted{Encrypted: encrypted.Data}}, true
+	}
+
+	codec, ok := payloadCodecs[secret.Type()]
+	if !ok {
+		return nil, false
+	}
+
+	payload := codec.toPB(secret)
+	return payload, payload != nil
+}
+
+func passwordToPB(password *models.PasswordSecret) *pb.PasswordData {
+	urls := make([]*pb.PasswordURL, 0, len(password.URLs))
+	for _, passwordURL := range password.URLs {
+		urls = append(urls, &pb.PasswordURL{Url: passwordURL.URL, Match: pb.URLMatch(passwordURL.Match)})
+	}
+
+	return &pb.PasswordData{
+		Login:    password.Login,
+		Password: password.Password,
+		Urls:     urls,
+	}
+}
+
+// passwordURLsFromPB converts urls leaving unspecified match rules to the validator.
+func passwordURLsFromPB(data []*pb.PasswordURL) []models.PasswordURL {
+	urls := make([]models.PasswordURL, 0, len(data))
+	for _, passwordURL := range data {
+		urls = append(urls, models.PasswordURL{URL: passwordURL.GetUrl(), Match: models.URLMatch(passwordURL.GetMatch())})
+	}
+	return urls
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// AuditAction is a kind of action that is recorded in the audit log.
+type AuditAction int
+
+const (
+	AuditActionLogin AuditAction = iota + 1
+	AuditActionRegister
+	AuditActionCreate
+	AuditActionRead
+	AuditActionUpdate
+	AuditActionDelete
+	AuditActionShare
+	AuditActionReveal
+)
+
+func (a AuditAction) String() string {
+	switch a {
+	case AuditActionLogin:
+		return "login"
+	case AuditActionRegister:
+		return "register"
+	case AuditActionCreate:
+		return "create"
+	case AuditActionRead:
+		return "read"
+	case AuditActionUpdate:
+		return "update"
+	case AuditActionDelete:
+		return "delete"
+	case AuditActionShare:
+		return "share"
+	case AuditActionReveal:
+		return "reveal"
+	}
+	return ""
+}
+
+// AuditEvent is a record of an action of the user. Outcome is the grpc status code of the request.
+// OwnerID and CollectionID are the vault that action was made in, events are listed for the user and for the vault.
+// Username is used to find the user of failed logins and registrations and ShareLinkTokenHash is used
+// to find the owner of redeemed share link, they are not stored.
+// Actor is the username of another user that made the action in the vault, it is set only in listed events.
+type AuditEvent struct {
+	CreatedAt          time.Time
+	Username           string
+	Actor              string
+	Method             string
+	SecretName         string
+	ClientAddress      string
+	Outcome            string
+	ShareLinkTokenHash []byte
+	ID                 int64
+	SecretID           int64
+	CollectionID       int64
+	UserID             int
+	OwnerID            int
+	SecretType         SecretType
+	Action             AuditAction
+}
+
+// AuditFilter selects audit events of the user, of the vault of the user
+// and of collections of organizations where the user is admin or owner. Zero From and To don't limit time of events,
+// empty Actions select all actions.
+type AuditFilter struct {
+	From    time.Time
+	To      time.Time
+	Actions []AuditAction
+	Cursor  int64
+	UserID  int
+	Limit   int
+}
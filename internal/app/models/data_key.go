@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// DataKey is a random key that encrypts secrets of one vault: personal vault of UserID or collection.
+// It is stored wrapped, i.e. encrypted with the master key of the server.
+type DataKey struct {
+	CreatedAt    time.Time
+	WrappedKey   []byte
+	ID           int64
+	CollectionID int64
+	UserID       int
+}
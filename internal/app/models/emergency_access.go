@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// EmergencyAccessStatus is a state of emergency access of the contact to the vault of its owner.
+type EmergencyAccessStatus int
+
+const (
+	// EmergencyAccessNominated means that contact can request access
+	EmergencyAccessNominated EmergencyAccessStatus = iota + 1
+	// EmergencyAccessRequested means that contact gets access after the wait period unless owner rejects it
+	EmergencyAccessRequested
+	// EmergencyAccessApproved means that owner approved access before the wait period ended
+	EmergencyAccessApproved
+	// EmergencyAccessRejected means that owner rejected the request, contact can request access again
+	EmergencyAccessRejected
+)
+
+func (s EmergencyAccessStatus) String() string {
+	switch s {
+	case EmergencyAccessNominated:
+		return "nominated"
+	case EmergencyAccessRequested:
+		return "requested"
+	case EmergencyAccessApproved:
+		return "approved"
+	case EmergencyAccessRejected:
+		return "rejected"
+	}
+	return ""
+}
+
+// EmergencyContact is a user that owner trusts to get read-only access to the vault
+// when the owner is unreachable.
+type EmergencyContact struct {
+	CreatedAt   time.Time
+	RequestedAt *time.Time
+	OwnerName   string
+	ContactName string
+	WaitPeriod  time.Duration
+	OwnerID     int
+	ContactID   int
+	Status      EmergencyAccessStatus
+}
+
+// AccessGranted reports whether the contact has access to the vault of the owner at the moment.
+func (c EmergencyContact) AccessGranted(now time.Time) bool {
+	if c.Status == EmergencyAccessApproved {
+		return true
+	}
+
+	return c.Status == EmergencyAccessRequested && c.RequestedAt != nil && !now.Before(c.RequestedAt.Add(c.WaitPeriod))
+}
@@ -0,0 +1,13 @@
+package models
+
+// PathSeparator separates folders in names of secrets, like prod/db/postgres.
+// Folders exist while they contain secrets or were created explicitly.
+const PathSeparator = "/"
+
+// FolderContents lists direct children of a folder. Empty path is the root folder.
+type FolderContents struct {
+	Path string
+	// Folders contains names of subfolders without the path of the folder
+	Folders []string
+	Secrets []SecretMetadata
+}
@@ -0,0 +1,12 @@
+package models
+
+// KDFParams are parameters of Argon2id that client uses to derive vault key from master password.
+// They are generated once for every user, Memory is in KiB.
+// KeyCheck is a known value encrypted with vault key, it is set by the client on the first unlock of the vault.
+type KDFParams struct {
+	Salt     []byte
+	KeyCheck []byte
+	Time     uint32
+	Memory   uint32
+	Threads  uint8
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// DueReason tells why secret needs attention.
+type DueReason string
+
+const (
+	// DueReasonExpiry means that secret reaches its ExpiresAt
+	DueReasonExpiry DueReason = "expiry"
+	// DueReasonRotation means that secret was not updated for its RotateEvery
+	DueReasonRotation DueReason = "rotation"
+)
+
+// DueSecret is a secret that is overdue or will be due soon.
+type DueSecret struct {
+	DueAt    time.Time
+	Reason   DueReason
+	Metadata SecretMetadata
+}
+
+// Notification tells user that secret is due. There is at most one notification
+// for every secret, reason and due date.
+type Notification struct {
+	CreatedAt  time.Time
+	DueAt      time.Time
+	Reason     DueReason
+	SecretName string
+	ID         int64
+	SecretID   int64
+	SecretType SecretType
+	UserID     int
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// OrganizationRole is a role of the member of organization. Every role has all permissions of lower roles.
+type OrganizationRole int
+
+const (
+	// RoleReadOnly allows to read secrets of organization collections
+	RoleReadOnly OrganizationRole = iota + 1
+	// RoleMember also allows to create, update and delete secrets
+	RoleMember
+	// RoleAdmin also allows to manage members and collections
+	RoleAdmin
+	// RoleOwner also allows to manage admins and other owners
+	RoleOwner
+)
+
+func (r OrganizationRole) String() string {
+	switch r {
+	case RoleReadOnly:
+		return "read-only"
+	case RoleMember:
+		return "member"
+	case RoleAdmin:
+		return "admin"
+	case RoleOwner:
+		return "owner"
+	}
+	return ""
+}
+
+// Organization owns collections of secrets that are available to its members.
+// Role is the role of the user that organization is listed for.
+type Organization struct {
+	CreatedAt time.Time
+	Name      string
+	ID        int64
+	Role      OrganizationRole
+}
+
+type OrganizationMember struct {
+	CreatedAt time.Time
+	Username  string
+	UserID    int
+	Role      OrganizationRole
+}
+
+// Collection is a vault of organization secrets.
+type Collection struct {
+	CreatedAt      time.Time
+	Name           string
+	ID             int64
+	OrganizationID int64
+}
@@ -0,0 +1,35 @@
+package models
+
+import "fmt"
+
+// secretTypes maps every known secret type to constructor of its empty secret.
+var secretTypes = map[SecretType]func() Secret{} //nolint:gochecknoglobals
+
+func init() {
+	RegisterSecretType(SecretTypePassword, func() Secret { return &PasswordSecret{} })
+	RegisterSecretType(SecretTypeCard, func() Secret { return &CardSecret{} })
+	RegisterSecretType(SecretTypeText, func() Secret { return &TextSecret{} })
+	RegisterSecretType(SecretTypeBinary, func() Secret { return &BinarySecret{} })
+	RegisterSecretType(SecretTypeCustom, func() Secret { return &CustomSecret{} })
+	RegisterSecretType(SecretTypeTOTP, func() Secret { return &TOTPSecret{} })
+	RegisterSecretType(SecretTypeSSHKey, func() Secret { return &SSHKeySecret{} })
+}
+
+// RegisterSecretType makes secrets of secretType decodable with NewSecret.
+// It panics if secretType is already registered.
+func RegisterSecretType(secretType SecretType, newSecret func() Secret) {
+	if _, ok := secretTypes[secretType]; ok {
+		panic(fmt.Sprintf("secret type %d is already registered", secretType))
+	}
+	secretTypes[secretType] = newSecret
+}
+
+// NewSecret returns empty secret of secretType, or nil if secretType is not registered.
+func NewSecret(secretType SecretType) Secret {
+	newSecret, ok := secretTypes[secretType]
+	if !ok {
+		return nil
+	}
+
+	return newSecret()
+}
@@ -3,12 +3,17 @@ package models
 import (
 	"bytes"
 	"encoding/gob"
+	"time"
 )
 
 const (
 	SecretTypePassword SecretType = iota + 1
 	SecretTypeCard
 	SecretTypeText
+	SecretTypeBinary
+	SecretTypeCustom
+	SecretTypeTOTP
+	SecretTypeSSHKey
 )
 
 type SecretType int
@@ -21,23 +26,126 @@ func (s SecretType) String() string {
 		return "Login/Password pair"
 	case SecretTypeText:
 		return "Text"
+	case SecretTypeBinary:
+		return "File"
+	case SecretTypeCustom:
+		return "Custom"
+	case SecretTypeTOTP:
+		return "TOTP"
+	case SecretTypeSSHKey:
+		return "SSH Key"
 	}
 	return ""
 }
 
+// SecretMetadata contains non-secret information about secret.
+// Name is a path of the secret in folders, like prod/db/postgres.
+// New secrets can't take a name of active secret of another type.
+// Tags and Labels are not encrypted, so they can be used for filtering.
+// On update nil Tags or Labels keep the current values.
+// On update ExpiresAt and RotateEvery are replaced only if ExpiresAtSet and RotateEverySet are true.
+// Zero RotateEvery means that secret does not need rotation.
+// Shared selects secret by ID among secrets that other users shared with UserID
+// instead of own secret of UserID by Name.
+// Non-zero CollectionID selects secrets of organization collection instead of personal secrets of UserID.
+// ClientEncrypted secrets are encrypted by the client with its vault key, server can't read their data.
+// DataKeyID is the data key that encrypts data of the secret, zero means the master key of the server.
 type SecretMetadata struct {
-	Name   string
-	Type   SecretType
-	UserID int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+	ExpiresAt       *time.Time
+	Labels          map[string]string
+	Name            string
+	Tags            []string
+	ID              int64
+	Version         int64
+	CollectionID    int64
+	DataKeyID       int64
+	RotateEvery     time.Duration
+	Type            SecretType
+	UserID          int
+	Shared          bool
+	ClientEncrypted bool
+	ExpiresAtSet    bool
+	RotateEverySet  bool
+}
+
+// SecretRevision is a previous version of secret's data.
+type SecretRevision struct {
+	CreatedAt       time.Time
+	SecretID        int64
+	Version         int64
+	DataKeyID       int64
+	ClientEncrypted bool
+}
+
+// SecretsFilter describes which secrets of a user should be listed.
+// Zero values of Type, NamePrefix, Tags and Labels disable the corresponding filter.
+// Listed secrets have all of the Tags and Labels.
+// Cursor is the ID of the last secret of the previous page.
+// Deleted switches listing from active secrets to the trash.
+// Non-zero CollectionID lists secrets of organization collection instead of secrets of the user.
+type SecretsFilter struct {
+	Labels       map[string]string
+	NamePrefix   string
+	Tags         []string
+	Cursor       int64
+	CollectionID int64
+	Type         SecretType
+	UserID       int
+	Limit        int
+	Deleted      bool
 }
 
 type Secret interface {
 	ToBinary() ([]byte, error)
+	Type() SecretType
+}
+
+// MaskedSecret is a secret with sensitive parts that are hidden unless the secret is revealed explicitly.
+type MaskedSecret interface {
+	Secret
+	Masked() Secret
+}
+
+// LabeledSecret is a secret that exposes part of its data as labels.
+// These labels are merged into labels of the secret on every save.
+type LabeledSecret interface {
+	Secret
+	SecretLabels() map[string]string
+}
+
+// URLMatch is a rule of matching URL of password secret against URL of a site.
+type URLMatch int
+
+const (
+	// URLMatchBaseDomain matches any host with the same registrable domain, like gitlab.example.com and example.com
+	URLMatchBaseDomain URLMatch = iota + 1
+	// URLMatchHost matches the same host and port
+	URLMatchHost
+	// URLMatchPrefix matches URLs that start with URL of the secret
+	URLMatchPrefix
+	// URLMatchRegex matches URLs with URL of the secret as a regular expression
+	URLMatchRegex
+)
+
+const DefaultURLMatch = URLMatchBaseDomain
+
+// PasswordURL is a site where password is used.
+type PasswordURL struct {
+	URL   string
+	Match URLMatch
 }
 
 type PasswordSecret struct {
 	Login    string
 	Password string
+	URLs     []PasswordURL
+}
+
+func (p *PasswordSecret) Type() SecretType {
+	return SecretTypePassword
 }
 
 func (p *PasswordSecret) ToBinary() ([]byte, error) {
@@ -49,11 +157,47 @@ func (p *PasswordSecret) ToBinary() ([]byte, error) {
 	return buff.Bytes(), err
 }
 
+// Labels that are set on card secrets. They are not secret and are shown instead of the full number.
+const (
+	CardBrandLabel = "card.brand"
+	CardLast4Label = "card.last4"
+)
+
 type CardSecret struct {
 	Number     string
 	HolderName string
 	CCV        string
-	Date       string
+	Date       string // MM/YY
+	Brand      string
+}
+
+// Last4 returns last four digits of the card number.
+func (c *CardSecret) Last4() string {
+	if len(c.Number) <= 4 {
+		return c.Number
+	}
+	return c.Number[len(c.Number)-4:]
+}
+
+// Masked returns copy of the card without CCV and with all digits of the number except the last four hidden.
+func (c *CardSecret) Masked() Secret {
+	masked := *c
+	masked.Number = "**** " + c.Last4()
+	masked.CCV = ""
+
+	return &masked
+}
+
+// SecretLabels returns brand and last four digits of the card.
+func (c *CardSecret) SecretLabels() map[string]string {
+	return map[string]string{
+		CardBrandLabel: c.Brand,
+		CardLast4Label: c.Last4(),
+	}
+}
+
+func (c *CardSecret) Type() SecretType {
+	return SecretTypeCard
 }
 
 func (c *CardSecret) ToBinary() ([]byte, error) {
@@ -69,6 +213,10 @@ type TextSecret struct {
 	Text string
 }
 
+func (t *TextSecret) Type() SecretType {
+	return SecretTypeText
+}
+
 func (t *TextSecret) ToBinary() ([]byte, error) {
 	var buff bytes.Buffer
 
@@ -78,15 +226,37 @@ func (t *TextSecret) ToBinary() ([]byte, error) {
 	return buff.Bytes(), err
 }
 
-func NewSecret(secretType SecretType) Secret {
-	switch secretType {
-	case SecretTypePassword:
-		return &PasswordSecret{}
-	case SecretTypeCard:
-		return &CardSecret{}
-	case SecretTypeText:
-		return &TextSecret{}
-	}
+// BinarySecret describes uploaded file. Content of the file is stored separately in encrypted chunks.
+type BinarySecret struct {
+	FileName string
+	Checksum string // hex encoded SHA-256 of file content
+	Size     int64
+}
+
+func (b *BinarySecret) Type() SecretType {
+	return SecretTypeBinary
+}
+
+func (b *BinarySecret) ToBinary() ([]byte, error) {
+	var buff bytes.Buffer
+
+	enc := gob.NewEncoder(&buff)
+	err := enc.Encode(b)
+
+	return buff.Bytes(), err
+}
+
+// EncryptedSecret is a secret that is encrypted by the client in zero-knowledge mode.
+// Server stores Data as it is and only knows the type of the secret.
+type EncryptedSecret struct {
+	Data       []byte
+	SecretType SecretType
+}
+
+func (e *EncryptedSecret) Type() SecretType {
+	return e.SecretType
+}
 
-	return nil
+func (e *EncryptedSecret) ToBinary() ([]byte, error) {
+	return e.Data, nil
 }
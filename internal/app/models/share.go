@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// SharePermission is the level of access to a secret that is shared with another user.
+type SharePermission int
+
+const (
+	// SharePermissionRead allows to get and reveal the secret
+	SharePermissionRead SharePermission = iota + 1
+	// SharePermissionWrite also allows to update the secret
+	SharePermissionWrite
+)
+
+func (p SharePermission) String() string {
+	switch p {
+	case SharePermissionRead:
+		return "read-only"
+	case SharePermissionWrite:
+		return "read-write"
+	}
+	return ""
+}
+
+// SecretShare is an access of the user to a secret of another user.
+type SecretShare struct {
+	CreatedAt  time.Time
+	Username   string
+	SecretID   int64
+	UserID     int
+	Permission SharePermission
+}
+
+// SharedSecret is a secret that its owner shared with the user.
+type SharedSecret struct {
+	OwnerName  string
+	Metadata   SecretMetadata
+	Permission SharePermission
+}
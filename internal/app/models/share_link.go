@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ShareLink is a snapshot of a secret that can be redeemed without an account
+// at most MaxRedemptions times before ExpiresAt.
+type ShareLink struct {
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	SecretName     string
+	ID             int64
+	SecretType     SecretType
+	UserID         int
+	MaxRedemptions int
+	Redemptions    int
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// Labels that are set on SSH key secrets, so they can be found by their public part.
+const (
+	SSHKeyTypeLabel        = "ssh.key_type"
+	SSHKeyFingerprintLabel = "ssh.fingerprint"
+	SSHKeyPublicKeyLabel   = "ssh.public_key"
+)
+
+// SSHKeySecret is SSH private key. Public part is derived from the private key when secret is saved.
+type SSHKeySecret struct {
+	PrivateKey  string // PEM encoded, possibly passphrase protected
+	Passphrase  string
+	Comment     string
+	PublicKey   string // in authorized_keys format
+	Fingerprint string // SHA256 fingerprint as printed by ssh-keygen
+	KeyType     string
+}
+
+func (k *SSHKeySecret) Type() SecretType {
+	return SecretTypeSSHKey
+}
+
+func (k *SSHKeySecret) ToBinary() ([]byte, error) {
+	var buff bytes.Buffer
+
+	enc := gob.NewEncoder(&buff)
+	err := enc.Encode(k)
+
+	return buff.Bytes(), err
+}
+
+// SecretLabels returns public part of the key, so secrets can be listed by fingerprint.
+func (k *SSHKeySecret) SecretLabels() map[string]string {
+	return map[string]string{
+		SSHKeyTypeLabel:        k.KeyType,
+		SSHKeyFingerprintLabel: k.Fingerprint,
+		SSHKeyPublicKeyLabel:   k.PublicKey,
+	}
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"bytes"
+	"encoding/gob"
+	"time"
+)
+
+const (
+	FieldKindText FieldKind = iota + 1
+	FieldKindConcealed
+	FieldKindURL
+	FieldKindDate
+	FieldKindMultiline
+)
+
+// FieldKind defines how value of template field is validated and displayed.
+type FieldKind int
+
+func (k FieldKind) String() string {
+	switch k {
+	case FieldKindText:
+		return "Text"
+	case FieldKindConcealed:
+		return "Concealed"
+	case FieldKindURL:
+		return "URL"
+	case FieldKindDate:
+		return "Date"
+	case FieldKindMultiline:
+		return "Multiline text"
+	}
+	return ""
+}
+
+// TemplateField describes one field of user defined secret.
+type TemplateField struct {
+	Name     string    `json:"name"`
+	Kind     FieldKind `json:"kind"`
+	Required bool      `json:"required"`
+}
+
+// SecretTemplate is user defined structure of custom secrets.
+type SecretTemplate struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	Fields    []TemplateField
+	ID        int64
+	UserID    int
+}
+
+// CustomSecret is secret that conforms to user defined template.
+type CustomSecret struct {
+	Fields     map[string]string
+	TemplateID int64
+}
+
+func (c *CustomSecret) Type() SecretType {
+	return SecretTypeCustom
+}
+
+func (c *CustomSecret) ToBinary() ([]byte, error) {
+	var buff bytes.Buffer
+
+	enc := gob.NewEncoder(&buff)
+	err := enc.Encode(c)
+
+	return buff.Bytes(), err
+}
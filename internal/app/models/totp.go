@@ -0,0 +1,57 @@
+package models
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+const (
+	TOTPAlgorithmSHA1 TOTPAlgorithm = iota + 1
+	TOTPAlgorithmSHA256
+	TOTPAlgorithmSHA512
+)
+
+// Parameters of TOTP secrets that are used when they are not set explicitly.
+const (
+	DefaultTOTPAlgorithm = TOTPAlgorithmSHA1
+	DefaultTOTPDigits    = 6
+	DefaultTOTPPeriod    = 30
+)
+
+// TOTPAlgorithm is the HMAC hash function used to generate TOTP codes.
+type TOTPAlgorithm int
+
+func (a TOTPAlgorithm) String() string {
+	switch a {
+	case TOTPAlgorithmSHA1:
+		return "SHA1"
+	case TOTPAlgorithmSHA256:
+		return "SHA256"
+	case TOTPAlgorithmSHA512:
+		return "SHA512"
+	}
+	return ""
+}
+
+// TOTPSecret is RFC 6238 seed with parameters of code generation.
+type TOTPSecret struct {
+	Secret      string // base32 encoded seed
+	Issuer      string
+	AccountName string
+	Algorithm   TOTPAlgorithm
+	Digits      int
+	Period      int // seconds
+}
+
+func (t *TOTPSecret) Type() SecretType {
+	return SecretTypeTOTP
+}
+
+func (t *TOTPSecret) ToBinary() ([]byte, error) {
+	var buff bytes.Buffer
+
+	enc := gob.NewEncoder(&buff)
+	err := enc.Encode(t)
+
+	return buff.Bytes(), err
+}
@@ -0,0 +1,246 @@
+package proto
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// auditedMethod is an action of audited method. Type is set for methods that work with secrets of one type.
+type auditedMethod struct {
+	secretType models.SecretType
+	action     models.AuditAction
+}
+
+// auditedMethods are methods that are recorded in the audit log.
+var auditedMethods = map[string]auditedMethod{ //nolint:gochecknoglobals
+	"/Auth/Login":    {action: models.AuditActionLogin},
+	"/Auth/Register": {action: models.AuditActionRegister},
+
+	"/Secrets/PutSecret":    {action: models.AuditActionUpdate},
+	"/Secrets/SavePassword": {action: models.AuditActionCreate, secretType: models.SecretTypePassword},
+	"/Secrets/SaveCard":     {action: models.AuditActionCreate, secretType: models.SecretTypeCard},
+	"/Secrets/SaveText":     {action: models.AuditActionCreate, secretType: models.SecretTypeText},
+	"/Secrets/UploadFile":   {action: models.AuditActionCreate, secretType: models.SecretTypeBinary},
+	"/Secrets/ImportTOTP":   {action: models.AuditActionCreate, secretType: models.SecretTypeTOTP},
+
+	"/Secrets/GetSecret":    {action: models.AuditActionRead},
+	"/Secrets/RevealSecret": {action: models.AuditActionReveal},
+	"/Secrets/GetPassword":  {action: models.AuditActionRead, secretType: models.SecretTypePassword},
+	"/Secrets/GetCard":      {action: models.AuditActionRead, secretType: models.SecretTypeCard},
+	"/Secrets/RevealCard":   {action: models.AuditActionReveal, secretType: models.SecretTypeCard},
+	"/Secrets/GetText":      {action: models.AuditActionRead, secretType: models.SecretTypeText},
+	"/Secrets/DownloadFile": {action: models.AuditActionRead, secretType: models.SecretTypeBinary},
+	"/Secrets/GetRevision":  {action: models.AuditActionRead},
+	"/Secrets/GetTOTPCode":  {action: models.AuditActionRead, secretType: models.SecretTypeTOTP},
+	"/Secrets/FindByURL":    {action: models.AuditActionRead, secretType: models.SecretTypePassword},
+
+	"/Secrets/SecurityReport":  {action: models.AuditActionRead},
+	"/Secrets/RedeemShareLink": {action: models.AuditActionRead},
+
+	"/Secrets/UpdatePassword":  {action: models.AuditActionUpdate, secretType: models.SecretTypePassword},
+	"/Secrets/UpdateCard":      {action: models.AuditActionUpdate, secretType: models.SecretTypeCard},
+	"/Secrets/UpdateText":      {action: models.AuditActionUpdate, secretType: models.SecretTypeText},
+	"/Secrets/RestoreRevision": {action: models.AuditActionUpdate},
+	"/Secrets/RestoreSecret":   {action: models.AuditActionUpdate},
+
+	"/Secrets/DeleteSecret": {action: models.AuditActionDelete},
+	"/Secrets/PurgeSecret":  {action: models.AuditActionDelete},
+
+	"/Secrets/RotateVaultKey": {action: models.AuditActionUpdate},
+	"/Secrets/DeleteVaultKey": {action: models.AuditActionDelete},
+
+	"/Secrets/ShareSecret":     {action: models.AuditActionShare},
+	"/Secrets/RevokeShare":     {action: models.AuditActionShare},
+	"/Secrets/CreateShareLink": {action: models.AuditActionShare},
+}
+
+// AuditInterceptor is a server interceptor that records calls of audited methods with their outcome.
+// It must be called before AuthInterceptor to record requests that were denied.
+type AuditInterceptor struct {
+	jwtManager *services.JWTManager
+	audit      services.AuditManagerInterface
+}
+
+// NewAuditInterceptor returns a new audit interceptor
+func NewAuditInterceptor(jwtManager *services.JWTManager, audit services.AuditManagerInterface) *AuditInterceptor {
+	return &AuditInterceptor{jwtManager: jwtManager, audit: audit}
+}
+
+// Unary returns a server interceptor function to audit unary RPC
+func (interceptor *AuditInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		interceptor.record(ctx, info.FullMethod, req, resp, err)
+
+		return resp, err
+	}
+}
+
+// Stream returns a server interceptor function to audit stream RPC. The first message of the stream is audited.
+func (interceptor *AuditInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		audited := &auditedStream{ServerStream: stream}
+		err := handler(srv, audited)
+		interceptor.record(stream.Context(), info.FullMethod, audited.request, nil, err)
+
+		return err
+	}
+}
+
+func (interceptor *AuditInterceptor) record(ctx context.Context, method string, req interface{}, resp interface{}, err error) {
+	audited, ok := auditedMethods[method]
+	if !ok {
+		return
+	}
+
+	event := models.AuditEvent{
+		Method:     method,
+		Outcome:    status.Code(err).String(),
+		SecretType: audited.secretType,
+		Action:     audited.action,
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		event.ClientAddress = p.Addr.String()
+	}
+
+	redeem, isRedeem := req.(*pb.RedeemShareLinkRequest)
+	switch {
+	case audited.action == models.AuditActionLogin || audited.action == models.AuditActionRegister:
+		event.UserID, event.Username = interceptor.authUser(req, resp)
+	case isRedeem:
+		// share links are redeemed anonymously, event is recorded for the owner of the link
+		event.ShareLinkTokenHash = services.ShareLinkTokenHash(redeem.GetToken())
+		if event.ShareLinkTokenHash == nil {
+			return
+		}
+		if r, ok := resp.(*pb.RedeemShareLinkResponse); ok {
+			event.SecretName = r.GetName()
+		}
+	default:
+		event.UserID, err = authenticate(ctx, interceptor.jwtManager)
+		if err != nil {
+			return
+		}
+		describeSecret(&event, req)
+		describeVault(ctx, &event)
+	}
+
+	interceptor.audit.RecordEvent(event)
+}
+
+// authUser returns id of the user from access token of successful login or registration.
+// Failed ones return username from the request.
+func (interceptor *AuditInterceptor) authUser(req interface{}, resp interface{}) (int, string) {
+	if tokenResponse, ok := resp.(interface{ GetAccessToken() string }); ok {
+		claims, err := interceptor.jwtManager.Verify(tokenResponse.GetAccessToken())
+		if err == nil {
+			return claims.Id, ""
+		}
+	}
+
+	if credentials, ok := req.(interface{ GetUsername() string }); ok {
+		return 0, credentials.GetUsername()
+	}
+
+	return 0, ""
+}
+
+// describeSecret sets identifier of the secret from request to the event.
+func describeSecret(event *models.AuditEvent, req interface{}) {
+	if upload, ok := req.(*pb.UploadFileRequest); ok {
+		req = upload.GetInfo()
+	}
+
+	switch r := req.(type) {
+	case interface{ GetName() string }:
+		event.SecretName = r.GetName()
+	case interface{ GetCardName() string }:
+		event.SecretName = r.GetCardName()
+	}
+	if r, ok := req.(interface{ GetType() pb.SecretType }); ok && r.GetType() != pb.SecretType_SECRET_TYPE_UNSPECIFIED {
+		event.SecretType = models.SecretType(r.GetType())
+	}
+	if r, ok := req.(interface{ GetSharedId() int64 }); ok {
+		event.SecretID = r.GetSharedId()
+	}
+	if r, ok := req.(interface{ GetId() int64 }); ok {
+		event.SecretID = r.GetId()
+	}
+
+	if put, ok := req.(*pb.PutSecretRequest); ok {
+		// encrypted payloads have type in the request
+		if secretType := payloadType(put.GetPayload()); secretType != 0 {
+			event.SecretType = secretType
+		}
+		if put.GetVersion() == 0 {
+			event.Action = models.AuditActionCreate
+		}
+	}
+}
+
+// describeVault sets collection or owner of the vault from request to the event.
+// Vaults of secrets that are identified by id are found when the event is saved.
+func describeVault(ctx context.Context, event *models.AuditEvent) {
+	collectionID, _ := CollectionIDFromContext(ctx)
+	ownerID, _ := EmergencyOwnerIDFromContext(ctx)
+
+	switch {
+	case collectionID != 0:
+		event.CollectionID = collectionID
+	case ownerID != 0:
+		event.OwnerID = ownerID
+	case event.SecretID == 0:
+		event.OwnerID = event.UserID
+	}
+}
+
+func payloadType(payload *pb.SecretPayload) models.SecretType {
+	switch payload.GetPayload().(type) {
+	case *pb.SecretPayload_Password:
+		return models.SecretTypePassword
+	case *pb.SecretPayload_Card:
+		return models.SecretTypeCard
+	case *pb.SecretPayload_Text:
+		return models.SecretTypeText
+	case *pb.SecretPayload_File:
+		return models.SecretTypeBinary
+	case *pb.SecretPayload_Custom:
+		return models.SecretTypeCustom
+	case *pb.SecretPayload_Totp:
+		return models.SecretTypeTOTP
+	case *pb.SecretPayload_SshKey:
+		return models.SecretTypeSSHKey
+	}
+	return 0
+}
+
+// auditedStream remembers the first message that is received from the client.
+type auditedStream struct {
+	grpc.ServerStream
+	request interface{}
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+
+	return err
+}
@@ -11,14 +11,27 @@ import (
 	"strings"
 )
 
-// AuthInterceptor is a server interceptor for authentication and authorization
+// publicMethods are methods that are called without authentication.
+var publicMethods = map[string]bool{ //nolint:gochecknoglobals
+	"/Secrets/RedeemShareLink": true,
+}
+
+// AuthInterceptor is a server interceptor for authentication and authorization.
+// Requests to organization collections are authorized by role of the user in organization,
+// requests to vaults of other users are authorized by emergency access
 type AuthInterceptor struct {
-	jwtManager *services.JWTManager
+	jwtManager      *services.JWTManager
+	organizations   services.OrganizationsManagerInterface
+	emergencyAccess services.EmergencyAccessManagerInterface
 }
 
 // NewAuthInterceptor returns a new auth interceptor
-func NewAuthInterceptor(jwtManager *services.JWTManager) *AuthInterceptor {
-	return &AuthInterceptor{jwtManager}
+func NewAuthInterceptor(
+	jwtManager *services.JWTManager,
+	organizations services.OrganizationsManagerInterface,
+	emergencyAccess services.EmergencyAccessManagerInterface,
+) *AuthInterceptor {
+	return &AuthInterceptor{jwtManager: jwtManager, organizations: organizations, emergencyAccess: emergencyAccess}
 }
 
 // Unary returns a server interceptor function to authenticate and authorize unary RPC
@@ -57,10 +70,25 @@ func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 }
 
 func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) (int, error) {
-	if strings.HasPrefix(method, "/Auth") {
+	if strings.HasPrefix(method, "/Auth") || publicMethods[method] {
 		return 0, nil
 	}
 
+	userID, err := authenticate(ctx, interceptor.jwtManager)
+	if err != nil {
+		return 0, err
+	}
+
+	err = interceptor.authorizeVault(ctx, method, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// authenticate returns id of the user whose access token is in request metadata.
+func authenticate(ctx context.Context, jwtManager *services.JWTManager) (int, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return 0, status.Errorf(codes.Unauthenticated, "metadata is not provided")
@@ -72,7 +100,7 @@ func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string
 	}
 
 	accessToken := values[0]
-	claims, err := interceptor.jwtManager.Verify(accessToken)
+	claims, err := jwtManager.Verify(accessToken)
 	if err != nil {
 		return 0, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
 	}
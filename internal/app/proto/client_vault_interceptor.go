@@ -0,0 +1,643 @@
+package proto
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ClientVaultInterceptor is a client interceptor for zero-knowledge mode. It encrypts payloads of secrets
+// of the personal vault with vault key before they are sent to the server and decrypts them in replies.
+// Per type RPCs are sent as PutSecret and GetSecret with encrypted payload and files are encrypted chunk by chunk.
+// Other methods of Secrets service are rejected unless they are known to send no secret data to the server.
+// Secrets of collections, emergency access and shared secrets are sent as they are,
+// because other users don't have the vault key.
+// Calls of other services that send password in plaintext or receive password generated by the server are rejected.
+// Interceptor does nothing until it is unlocked with vault key.
+type ClientVaultInterceptor struct {
+	cryptographer services.Cryptographer
+}
+
+// NewClientVaultInterceptor returns a new locked vault interceptor
+func NewClientVaultInterceptor() *ClientVaultInterceptor {
+	return &ClientVaultInterceptor{}
+}
+
+// Unlock makes interceptor encrypt secrets with key that is derived with services.DeriveVaultKey.
+func (interceptor *ClientVaultInterceptor) Unlock(key []byte) {
+	interceptor.cryptographer = &services.GCMAESCryptographer{
+		Random: &services.TrulyRandomGenerator{},
+		Key:    key,
+	}
+}
+
+// plaintextFreeMethods are methods of Secrets service that are sent as they are in zero-knowledge mode,
+// because they don't send secret data to the server.
+var plaintextFreeMethods = map[string]bool{ //nolint:gochecknoglobals
+	"/Secrets/ListSecrets":      true,
+	"/Secrets/DeleteSecret":     true,
+	"/Secrets/ListTrash":        true,
+	"/Secrets/RestoreSecret":    true,
+	"/Secrets/PurgeSecret":      true,
+	"/Secrets/ListRevisions":    true,
+	"/Secrets/RestoreRevision":  true,
+	"/Secrets/SecurityReport":   true,
+	"/Secrets/ListExpiring":     true,
+	"/Secrets/FindByURL":        true,
+	"/Secrets/ShareSecret":      true,
+	"/Secrets/RevokeShare":      true,
+	"/Secrets/ListShares":       true,
+	"/Secrets/ListSharedWithMe": true,
+	"/Secrets/CreateShareLink":  true,
+	"/Secrets/RedeemShareLink":  true,
+	"/Secrets/RotateVaultKey":   true,
+	"/Secrets/DeleteVaultKey":   true,
+}
+
+// serverPasswordMethods are methods that reply with password generated by the server.
+var serverPasswordMethods = map[string]bool{ //nolint:gochecknoglobals
+	"/Passwords/GeneratePassword": true,
+}
+
+// Unary returns a client interceptor to encrypt and decrypt secrets in unary RPC
+func (interceptor *ClientVaultInterceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if interceptor.cryptographer != nil && !strings.HasPrefix(method, "/Secrets/") && !strings.HasPrefix(method, "/Auth/") {
+			if message, ok := req.(protobuf.Message); serverPasswordMethods[method] || ok && sendsPassword(message.ProtoReflect()) {
+				return status.Errorf(codes.FailedPrecondition, "%s is not supported in zero-knowledge mode, it reveals password to the server", method)
+			}
+		}
+
+		if interceptor.cryptographer == nil || !isPersonalVault(ctx) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		vault := &vaultCall{interceptor: interceptor, ctx: ctx, cc: cc, invoker: invoker, opts: opts}
+		switch method {
+		case "/Secrets/PutSecret":
+			return vault.putSecret(req.(*pb.PutSecretRequest), reply.(*pb.PutSecretResponse))
+		case "/Secrets/GetSecret", "/Secrets/RevealSecret":
+			return vault.getSecret(method, req.(*pb.GetSecretRequest), reply.(*pb.SecretResponse))
+		case "/Secrets/GetRevision":
+			return vault.getRevision(req.(*pb.RevisionRequest), reply.(*pb.RevisionResponse))
+		case "/Secrets/SavePassword":
+			r := req.(*pb.SavePasswordRequest)
+			return vault.putSecret(&pb.PutSecretRequest{
+				Name: r.GetName(),
+				Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Password{Password: &pb.PasswordData{
+					Login:    r.GetLogin(),
+					Password: r.GetPassword(),
+					Urls:     r.GetUrls(),
+				}}},
+				Attributes: r.GetAttributes(),
+			}, reply.(*pb.PutSecretResponse))
+		case "/Secrets/SaveCard":
+			r := req.(*pb.SaveCardRequest)
+			return vault.putSecret(&pb.PutSecretRequest{
+				Name: r.GetCardName(),
+				Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Card{Card: &pb.CardData{
+					Number:     r.GetNumber(),
+					HolderName: r.GetHolderName(),
+					Date:       r.GetDate(),
+					Ccv:        r.GetCcv(),
+				}}},
+				Attributes: r.GetAttributes(),
+			}, &pb.PutSecretResponse{})
+		case "/Secrets/SaveText":
+			r := req.(*pb.SaveTextRequest)
+			return vault.putSecret(&pb.PutSecretRequest{
+				Name:       r.GetName(),
+				Payload:    &pb.SecretPayload{Payload: &pb.SecretPayload_Text{Text: &pb.TextData{Text: r.GetText()}}},
+				Attributes: r.GetAttributes(),
+			}, &pb.PutSecretResponse{})
+		case "/Secrets/UpdatePassword":
+			r := req.(*pb.UpdatePasswordRequest)
+			return vault.updateSecret(&pb.PutSecretRequest{
+				Name: r.GetName(),
+				Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Password{Password: &pb.PasswordData{
+					Login:    r.GetLogin(),
+					Password: r.GetPassword(),
+					Urls:     r.GetUrls(),
+				}}},
+				Version:    r.GetVersion(),
+				Attributes: r.GetAttributes(),
+			}, reply.(*pb.UpdateSecretResponse))
+		case "/Secrets/UpdateCard":
+			r := req.(*pb.UpdateCardRequest)
+			return vault.updateSecret(&pb.PutSecretRequest{
+				Name: r.GetCardName(),
+				Payload: &pb.SecretPayload{Payload: &pb.SecretPayload_Card{Card: &pb.CardData{
+					Number:     r.GetNumber(),
+					HolderName: r.GetHolderName(),
+					Date:       r.GetDate(),
+					Ccv:        r.GetCcv(),
+				}}},
+				Version:    r.GetVersion(),
+				Attributes: r.GetAttributes(),
+			}, reply.(*pb.UpdateSecretResponse))
+		case "/Secrets/UpdateText":
+			r := req.(*pb.UpdateTextRequest)
+			return vault.updateSecret(&pb.PutSecretRequest{
+				Name:       r.GetName(),
+				Payload:    &pb.SecretPayload{Payload: &pb.SecretPayload_Text{Text: &pb.TextData{Text: r.GetText()}}},
+				Version:    r.GetVersion(),
+				Attributes: r.GetAttributes(),
+			}, reply.(*pb.UpdateSecretResponse))
+		case "/Secrets/GetPassword":
+			return vault.getPassword(req.(*pb.GetSecretRequest), reply.(*pb.PasswordResponse))
+		case "/Secrets/GetCard":
+			return vault.getCard("/Secrets/GetSecret", req.(*pb.GetSecretRequest), reply.(*pb.CardResponse))
+		case "/Secrets/RevealCard":
+			return vault.getCard("/Secrets/RevealSecret", req.(*pb.GetSecretRequest), reply.(*pb.CardResponse))
+		case "/Secrets/GetText":
+			return vault.getText(req.(*pb.GetSecretRequest), reply.(*pb.TextResponse))
+		case "/Secrets/GetTOTPCode":
+			return vault.getTOTPCode(req.(*pb.GetTOTPCodeRequest), reply.(*pb.TOTPCodeResponse))
+		case "/Secrets/ImportTOTP":
+			return status.Errorf(codes.FailedPrecondition, "otpauth uri is parsed by the server, enter TOTP secret manually")
+		}
+
+		if strings.HasPrefix(method, "/Secrets/") && !plaintextFreeMethods[method] {
+			return status.Errorf(codes.FailedPrecondition, "%s is not supported in zero-knowledge mode", method)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Stream returns a client interceptor that encrypts uploaded files and decrypts downloaded ones.
+// Every chunk is encrypted separately, name, size and checksum of the file are sent encrypted after the content.
+func (interceptor *ClientVaultInterceptor) Stream() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		if interceptor.cryptographer == nil || !isPersonalVault(ctx) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		if method != "/Secrets/UploadFile" && method != "/Secrets/DownloadFile" && strings.HasPrefix(method, "/Secrets/") {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is not supported in zero-knowledge mode", method)
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		switch method {
+		case "/Secrets/UploadFile":
+			return &encryptedUploadStream{ClientStream: stream, interceptor: interceptor, hash: sha256.New()}, nil
+		case "/Secrets/DownloadFile":
+			return &encryptedDownloadStream{ClientStream: stream, interceptor: interceptor}, nil
+		}
+		return stream, nil
+	}
+}
+
+// encryptPayload serializes payload and encrypts it with vault key.
+func (interceptor *ClientVaultInterceptor) encryptPayload(payload *pb.SecretPayload) (*pb.SecretPayload, error) {
+	data, err := protobuf.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := interceptor.cryptographer.Encrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SecretPayload{Payload: &pb.SecretPayload_Encrypted{Encrypted: encrypted}}, nil
+}
+
+// decryptPayload decrypts payload that is encrypted with vault key. Other payloads are returned as they are.
+func (interceptor *ClientVaultInterceptor) decryptPayload(payload *pb.SecretPayload) (*pb.SecretPayload, error) {
+	encrypted, ok := payload.GetPayload().(*pb.SecretPayload_Encrypted)
+	if !ok {
+		return payload, nil
+	}
+
+	return interceptor.decrypt(encrypted.Encrypted)
+}
+
+func (interceptor *ClientVaultInterceptor) decrypt(encrypted []byte) (*pb.SecretPayload, error) {
+	data, err := interceptor.cryptographer.Decrypt(encrypted)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot decrypt secret, check master password: %v", err)
+	}
+
+	payload := &pb.SecretPayload{}
+	err = protobuf.Unmarshal(data, payload)
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "cannot decode decrypted secret: %v", err)
+	}
+
+	return payload, nil
+}
+
+// vaultCall sends requests with encrypted payloads on behalf of one intercepted call.
+type vaultCall struct {
+	ctx         context.Context
+	interceptor *ClientVaultInterceptor
+	cc          *grpc.ClientConn
+	invoker     grpc.UnaryInvoker
+	opts        []grpc.CallOption
+}
+
+func (v *vaultCall) invoke(method string, req, reply interface{}) error {
+	return v.invoker(v.ctx, method, req, reply, v.cc, v.opts...)
+}
+
+// putSecret sends PutSecret with encrypted payload. Shared secrets are sent as they are.
+func (v *vaultCall) putSecret(request *pb.PutSecretRequest, reply *pb.PutSecretResponse) error {
+	if request.GetSharedId() != 0 {
+		return v.invoke("/Secrets/PutSecret", request, reply)
+	}
+	if _, ok := request.GetPayload().GetPayload().(*pb.SecretPayload_Encrypted); ok {
+		return v.invoke("/Secrets/PutSecret", request, reply)
+	}
+
+	secretType := payloadType(request.GetPayload())
+	if secretType == 0 || secretType == models.SecretTypeBinary {
+		return status.Errorf(codes.InvalidArgument, "unsupported secret payload")
+	}
+
+	payload, err := v.interceptor.encryptPayload(request.GetPayload())
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot encrypt secret: %v", err)
+	}
+
+	encrypted := protobuf.Clone(request).(*pb.PutSecretRequest)
+	encrypted.Payload = payload
+	encrypted.Type = pb.SecretType(secretType)
+
+	return v.invoke("/Secrets/PutSecret", encrypted, reply)
+}
+
+// updateSecret puts existing secret. Like UpdatePassword and others it never creates new secret.
+func (v *vaultCall) updateSecret(request *pb.PutSecretRequest, reply *pb.UpdateSecretResponse) error {
+	if request.GetVersion() == 0 {
+		return status.Errorf(codes.InvalidArgument, "version of updated secret is required")
+	}
+
+	response := &pb.PutSecretResponse{}
+	err := v.putSecret(request, response)
+	if err != nil {
+		return err
+	}
+
+	reply.Version = response.GetVersion()
+	reply.Strength = response.GetStrength()
+	reply.Breach = response.GetBreach()
+
+	return nil
+}
+
+// getSecret gets secret with GetSecret or RevealSecret and decrypts its payload.
+// Cards that are encrypted by the client are masked by the client, like the server does.
+func (v *vaultCall) getSecret(method string, request *pb.GetSecretRequest, reply *pb.SecretResponse) error {
+	err := v.invoke(method, request, reply)
+	if err != nil {
+		return err
+	}
+
+	encrypted := reply.GetMetadata().GetClientEncrypted()
+	reply.Payload, err = v.interceptor.decryptPayload(reply.GetPayload())
+	if err != nil {
+		return err
+	}
+
+	if card := reply.GetPayload().GetCard(); card != nil && encrypted {
+		card.Last4 = (&models.CardSecret{Number: card.GetNumber()}).Last4()
+		if method == "/Secrets/GetSecret" {
+			card.Number = "**** " + card.Last4
+			card.Ccv = ""
+		}
+	}
+
+	return nil
+}
+
+func (v *vaultCall) getRevision(request *pb.RevisionRequest, reply *pb.RevisionResponse) error {
+	err := v.invoke("/Secrets/GetRevision", request, reply)
+	if err != nil {
+		return err
+	}
+
+	encrypted, ok := reply.GetSecret().(*pb.RevisionResponse_Encrypted)
+	if !ok {
+		return nil
+	}
+
+	payload, err := v.interceptor.decrypt(encrypted.Encrypted)
+	if err != nil {
+		return err
+	}
+
+	version := reply.GetRevision().GetVersion()
+	switch data := payload.GetPayload().(type) {
+	case *pb.SecretPayload_Password:
+		reply.Secret = &pb.RevisionResponse_Password{Password: &pb.PasswordResponse{
+			Login:    data.Password.GetLogin(),
+			Password: data.Password.GetPassword(),
+			Version:  version,
+			Urls:     data.Password.GetUrls(),
+		}}
+	case *pb.SecretPayload_Card:
+		masked := (&models.CardSecret{Number: data.Card.GetNumber()}).Masked().(*models.CardSecret)
+		reply.Secret = &pb.RevisionResponse_Card{Card: &pb.CardResponse{
+			Number:     masked.Number,
+			HolderName: data.Card.GetHolderName(),
+			Date:       data.Card.GetDate(),
+			Version:    version,
+			Last4:      masked.Last4(),
+		}}
+	case *pb.SecretPayload_Text:
+		reply.Secret = &pb.RevisionResponse_Text{Text: &pb.TextResponse{
+			Text:    data.Text.GetText(),
+			Version: version,
+		}}
+	default:
+		return status.Errorf(codes.Internal, "unexpected secret payload %T", data)
+	}
+
+	return nil
+}
+
+func (v *vaultCall) getPassword(request *pb.GetSecretRequest, reply *pb.PasswordResponse) error {
+	secret := &pb.SecretResponse{}
+	err := v.getSecret("/Secrets/GetSecret", &pb.GetSecretRequest{
+		Name:     request.GetName(),
+		Type:     pb.SecretType_SECRET_TYPE_PASSWORD,
+		SharedId: request.GetSharedId(),
+	}, secret)
+	if err != nil {
+		return err
+	}
+
+	reply.Login = secret.GetPayload().GetPassword().GetLogin()
+	reply.Password = secret.GetPayload().GetPassword().GetPassword()
+	reply.Version = secret.GetMetadata().GetVersion()
+	reply.Urls = secret.GetPayload().GetPassword().GetUrls()
+
+	return nil
+}
+
+func (v *vaultCall) getCard(method string, request *pb.GetSecretRequest, reply *pb.CardResponse) error {
+	secret := &pb.SecretResponse{}
+	err := v.getSecret(method, &pb.GetSecretRequest{
+		Name:     request.GetName(),
+		Type:     pb.SecretType_SECRET_TYPE_CARD,
+		SharedId: request.GetSharedId(),
+	}, secret)
+	if err != nil {
+		return err
+	}
+
+	card := secret.GetPayload().GetCard()
+	reply.Number = card.GetNumber()
+	reply.HolderName = card.GetHolderName()
+	reply.Date = card.GetDate()
+	reply.Ccv = card.GetCcv()
+	reply.Version = secret.GetMetadata().GetVersion()
+	reply.Brand = card.GetBrand()
+	reply.Last4 = card.GetLast4()
+
+	return nil
+}
+
+func (v *vaultCall) getText(request *pb.GetSecretRequest, reply *pb.TextResponse) error {
+	secret := &pb.SecretResponse{}
+	err := v.getSecret("/Secrets/GetSecret", &pb.GetSecretRequest{
+		Name:     request.GetName(),
+		Type:     pb.SecretType_SECRET_TYPE_TEXT,
+		SharedId: request.GetSharedId(),
+	}, secret)
+	if err != nil {
+		return err
+	}
+
+	reply.Text = secret.GetPayload().GetText().GetText()
+	reply.Version = secret.GetMetadata().GetVersion()
+
+	return nil
+}
+
+// getTOTPCode generates code of TOTP secret that is encrypted by the client, because server can't do it.
+// Codes of other TOTP secrets are generated by the server.
+func (v *vaultCall) getTOTPCode(request *pb.GetTOTPCodeRequest, reply *pb.TOTPCodeResponse) error {
+	err := v.invoke("/Secrets/GetTOTPCode", request, reply)
+	if status.Code(err) != codes.FailedPrecondition {
+		return err
+	}
+
+	secret := &pb.SecretResponse{}
+	err = v.getSecret("/Secrets/RevealSecret", &pb.GetSecretRequest{
+		Name: request.GetName(),
+		Type: pb.SecretType_SECRET_TYPE_TOTP,
+	}, secret)
+	if err != nil {
+		return err
+	}
+
+	data := secret.GetPayload().GetTotp()
+	totp := &models.TOTPSecret{
+		Secret:    data.GetSecret(),
+		Algorithm: models.TOTPAlgorithm(data.GetAlgorithm()),
+		Digits:    int(data.GetDigits()),
+		Period:    int(data.GetPeriod()),
+	}
+	if totp.Algorithm == 0 {
+		totp.Algorithm = models.DefaultTOTPAlgorithm
+	}
+	if totp.Digits == 0 {
+		totp.Digits = models.DefaultTOTPDigits
+	}
+	if totp.Period == 0 {
+		totp.Period = models.DefaultTOTPPeriod
+	}
+
+	code, remaining, err := services.GenerateTOTPCode(totp, time.Now())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot generate totp code: %v", err)
+	}
+
+	reply.Code = code
+	reply.SecondsRemaining = int32(remaining.Seconds())
+
+	return nil
+}
+
+// sendsPassword tells if message or any message nested in it has non-empty password field.
+func sendsPassword(message protoreflect.Message) bool {
+	found := false
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.Kind() == protoreflect.StringKind && field.Name() == "password" && !field.IsList():
+			found = value.String() != ""
+		case field.Kind() == protoreflect.MessageKind && field.IsList():
+			for i := 0; i < value.List().Len() && !found; i++ {
+				found = sendsPassword(value.List().Get(i).Message())
+			}
+		case field.Kind() == protoreflect.MessageKind && !field.IsMap():
+			found = sendsPassword(value.Message())
+		}
+		return !found
+	})
+
+	return found
+}
+
+// isPersonalVault tells if outgoing request works with personal vault of the user.
+func isPersonalVault(ctx context.Context) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return len(md.Get(CollectionKey)) == 0 && len(md.Get(EmergencyOwnerKey)) == 0
+}
+
+// encryptedUploadStream encrypts file info and chunks of upload stream.
+type encryptedUploadStream struct {
+	grpc.ClientStream
+	interceptor *ClientVaultInterceptor
+	hash        hash.Hash
+	info        *pb.FileInfo
+}
+
+func (s *encryptedUploadStream) SendMsg(m interface{}) error {
+	request, ok := m.(*pb.UploadFileRequest)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected upload message %T", m)
+	}
+
+	switch data := request.GetData().(type) {
+	case *pb.UploadFileRequest_Info:
+		s.info = &pb.FileInfo{FileName: data.Info.GetFileName()}
+		return s.ClientStream.SendMsg(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Info{Info: &pb.FileInfo{
+			Name:            data.Info.GetName(),
+			Attributes:      data.Info.GetAttributes(),
+			ClientEncrypted: true,
+		}}})
+	case *pb.UploadFileRequest_Chunk:
+		s.hash.Write(data.Chunk)
+		s.info.Size += int64(len(data.Chunk))
+
+		chunk, err := s.interceptor.cryptographer.Encrypt(data.Chunk)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot encrypt file: %v", err)
+		}
+		return s.ClientStream.SendMsg(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Chunk{Chunk: chunk}})
+	}
+
+	return status.Errorf(codes.InvalidArgument, "unexpected upload message %T", request.GetData())
+}
+
+// CloseSend sends encrypted name, size and checksum of the file before closing the stream.
+func (s *encryptedUploadStream) CloseSend() error {
+	if s.info == nil {
+		return s.ClientStream.CloseSend()
+	}
+	s.info.Checksum = hex.EncodeToString(s.hash.Sum(nil))
+
+	data, err := protobuf.Marshal(s.info)
+	if err != nil {
+		return err
+	}
+	encryptedInfo, err := s.interceptor.cryptographer.Encrypt(data)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot encrypt file info: %v", err)
+	}
+
+	err = s.ClientStream.SendMsg(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_EncryptedInfo{EncryptedInfo: encryptedInfo}})
+	if err != nil {
+		return err
+	}
+
+	return s.ClientStream.CloseSend()
+}
+
+// RecvMsg replaces file info from the server, which only has encrypted content, with info of the uploaded file.
+func (s *encryptedUploadStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	if info, ok := m.(*pb.FileInfo); ok && s.info != nil {
+		info.FileName = s.info.GetFileName()
+		info.Size = s.info.GetSize()
+		info.Checksum = s.info.GetChecksum()
+	}
+
+	return nil
+}
+
+// encryptedDownloadStream decrypts file info and chunks of download stream.
+// Files that are not encrypted by the client are received as they are.
+type encryptedDownloadStream struct {
+	grpc.ClientStream
+	interceptor *ClientVaultInterceptor
+	encrypted   bool
+}
+
+func (s *encryptedDownloadStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	response, ok := m.(*pb.DownloadFileResponse)
+	if !ok {
+		return nil
+	}
+
+	switch data := response.GetData().(type) {
+	case *pb.DownloadFileResponse_EncryptedInfo:
+		decrypted, err := s.interceptor.cryptographer.Decrypt(data.EncryptedInfo)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "cannot decrypt file, check master password: %v", err)
+		}
+
+		info := &pb.FileInfo{}
+		err = protobuf.Unmarshal(decrypted, info)
+		if err != nil {
+			return status.Errorf(codes.DataLoss, "cannot decode decrypted file info: %v", err)
+		}
+
+		s.encrypted = true
+		response.Data = &pb.DownloadFileResponse_Info{Info: info}
+	case *pb.DownloadFileResponse_Chunk:
+		if !s.encrypted {
+			return nil
+		}
+
+		chunk, err := s.interceptor.cryptographer.Decrypt(data.Chunk)
+		if err != nil {
+			return status.Errorf(codes.DataLoss, "cannot decrypt file chunk: %v", err)
+		}
+		data.Chunk = chunk
+	}
+
+	return nil
+}
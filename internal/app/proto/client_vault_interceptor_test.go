@@ -0,0 +1,64 @@
+package proto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/belamov/ypgo-password-manager/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnlockedVaultRejectsPlaintextPasswords(t *testing.T) {
+	interceptor := NewClientVaultInterceptor()
+	interceptor.Unlock(make([]byte, 32))
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return nil
+	}
+
+	tests := []struct {
+		method string
+		req    interface{}
+		want   codes.Code
+	}{
+		{
+			method: "/Passwords/EstimatePasswordStrength",
+			req:    &pb.EstimatePasswordStrengthRequest{Password: "password"},
+			want:   codes.FailedPrecondition,
+		},
+		{
+			method: "/Passwords/CheckBreached",
+			req:    &pb.CheckBreachedRequest{Target: &pb.CheckBreachedRequest_Password{Password: "password"}},
+			want:   codes.FailedPrecondition,
+		},
+		{
+			method: "/Passwords/GeneratePassword",
+			req:    &pb.GeneratePasswordRequest{},
+			want:   codes.FailedPrecondition,
+		},
+		{
+			method: "/Passwords/CheckBreached",
+			req:    &pb.CheckBreachedRequest{Target: &pb.CheckBreachedRequest_SecretName{SecretName: "name"}},
+			want:   codes.OK,
+		},
+		{
+			method: "/Passwords/CheckBreachedRange",
+			req:    &pb.BreachRangeRequest{Prefix: "5BAA6"},
+			want:   codes.OK,
+		},
+		{
+			method: "/Passwords/GetPasswordPolicy",
+			req:    &pb.GetPasswordPolicyRequest{},
+			want:   codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			err := interceptor.Unary()(context.Background(), tt.method, tt.req, nil, nil, invoker)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
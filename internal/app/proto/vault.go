@@ -0,0 +1,179 @@
+package proto
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// CollectionKey is a metadata key with id of organization collection that request works with.
+	// Requests without it work with personal vault of the user.
+	CollectionKey = "collection-id"
+	// EmergencyOwnerKey is a metadata key with id of the user whose vault is read with emergency access.
+	EmergencyOwnerKey = "emergency-owner-id"
+)
+
+// vaultServices are services that work with secrets of the vault that is chosen by CollectionKey.
+var vaultServices = []string{"/Secrets/", "/Folders/"} //nolint:gochecknoglobals
+
+// vaultRoles are the lowest organization roles that are allowed to call methods in collections.
+// Other methods of vaultServices work only with personal vaults.
+var vaultRoles = map[string]models.OrganizationRole{ //nolint:gochecknoglobals
+	"/Secrets/GetSecret":       models.RoleReadOnly,
+	"/Secrets/RevealSecret":    models.RoleReadOnly,
+	"/Secrets/GetPassword":     models.RoleReadOnly,
+	"/Secrets/GetCard":         models.RoleReadOnly,
+	"/Secrets/RevealCard":      models.RoleReadOnly,
+	"/Secrets/GetText":         models.RoleReadOnly,
+	"/Secrets/ListSecrets":     models.RoleReadOnly,
+	"/Secrets/ListTrash":       models.RoleReadOnly,
+	"/Secrets/ListRevisions":   models.RoleReadOnly,
+	"/Secrets/GetRevision":     models.RoleReadOnly,
+	"/Secrets/PutSecret":       models.RoleMember,
+	"/Secrets/SavePassword":    models.RoleMember,
+	"/Secrets/SaveCard":        models.RoleMember,
+	"/Secrets/SaveText":        models.RoleMember,
+	"/Secrets/UpdatePassword":  models.RoleMember,
+	"/Secrets/UpdateCard":      models.RoleMember,
+	"/Secrets/UpdateText":      models.RoleMember,
+	"/Secrets/DeleteSecret":    models.RoleMember,
+	"/Secrets/RestoreSecret":   models.RoleMember,
+	"/Secrets/RestoreRevision": models.RoleMember,
+	"/Secrets/PurgeSecret":     models.RoleAdmin,
+	"/Secrets/CreateShareLink": models.RoleMember,
+	"/Secrets/UploadFile":      models.RoleMember,
+	"/Secrets/DownloadFile":    models.RoleReadOnly,
+	"/Secrets/ImportTOTP":      models.RoleMember,
+	"/Secrets/GetTOTPCode":     models.RoleReadOnly,
+	"/Secrets/ListExpiring":    models.RoleReadOnly,
+	"/Secrets/FindByURL":       models.RoleReadOnly,
+	"/Secrets/SecurityReport":  models.RoleReadOnly,
+	"/Secrets/RotateVaultKey":  models.RoleAdmin,
+	"/Secrets/DeleteVaultKey":  models.RoleOwner,
+	"/Folders/ListFolder":      models.RoleReadOnly,
+	"/Folders/CreateFolder":    models.RoleMember,
+	"/Folders/RenameFolder":    models.RoleMember,
+	"/Folders/MoveFolder":      models.RoleMember,
+	"/Folders/DeleteFolder":    models.RoleMember,
+}
+
+// CollectionIDFromContext returns id of collection from incoming metadata. Zero id means personal vault.
+func CollectionIDFromContext(ctx context.Context) (int64, error) {
+	return idFromContext(ctx, CollectionKey)
+}
+
+// EmergencyOwnerIDFromContext returns id of the owner of the vault that is read with emergency access.
+// Zero id means that request doesn't use emergency access.
+func EmergencyOwnerIDFromContext(ctx context.Context) (int, error) {
+	ownerID, err := idFromContext(ctx, EmergencyOwnerKey)
+	return int(ownerID), err
+}
+
+// WithCollection returns context for outgoing requests to the collection. Zero collectionID means personal vault.
+func WithCollection(ctx context.Context, collectionID int64) context.Context {
+	return withVault(ctx, CollectionKey, collectionID)
+}
+
+// WithEmergencyOwner returns context for outgoing requests to the vault of the owner with emergency access.
+// Zero ownerID means personal vault.
+func WithEmergencyOwner(ctx context.Context, ownerID int) context.Context {
+	return withVault(ctx, EmergencyOwnerKey, int64(ownerID))
+}
+
+func idFromContext(ctx context.Context, key string) (int64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(key)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || id < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s: %s", key, values[0])
+	}
+
+	return id, nil
+}
+
+// withVault replaces the vault of outgoing requests, requests work with one vault at a time.
+func withVault(ctx context.Context, key string, id int64) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Delete(CollectionKey)
+	md.Delete(EmergencyOwnerKey)
+	if id != 0 {
+		md.Set(key, strconv.FormatInt(id, 10))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// authorizeVault checks that user has role that is required to call method in the collection of request.
+// Emergency access allows only methods that are available to read-only members of collections.
+func (interceptor *AuthInterceptor) authorizeVault(ctx context.Context, method string, userID int) error {
+	collectionID, err := CollectionIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	ownerID, err := EmergencyOwnerIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if collectionID == 0 && ownerID == 0 {
+		return nil
+	}
+
+	inVaultService := false
+	for _, service := range vaultServices {
+		inVaultService = inVaultService || strings.HasPrefix(method, service)
+	}
+	if !inVaultService {
+		return nil
+	}
+
+	if collectionID != 0 && ownerID != 0 {
+		return status.Errorf(codes.InvalidArgument, "%s and %s can't be used together", CollectionKey, EmergencyOwnerKey)
+	}
+	if ownerID != 0 {
+		return interceptor.authorizeEmergencyAccess(method, userID, ownerID)
+	}
+
+	role, ok := vaultRoles[method]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "%s is not available in organization collections", method)
+	}
+
+	err = interceptor.organizations.AuthorizeCollection(userID, collectionID, role)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "cannot access collection %d: %v", collectionID, err)
+	}
+
+	return nil
+}
+
+func (interceptor *AuthInterceptor) authorizeEmergencyAccess(method string, userID int, ownerID int) error {
+	if role, ok := vaultRoles[method]; !ok || role != models.RoleReadOnly {
+		return status.Errorf(codes.InvalidArgument, "%s is not available with emergency access", method)
+	}
+
+	err := interceptor.emergencyAccess.AuthorizeEmergencyAccess(userID, ownerID)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "cannot access vault of user %d: %v", ownerID, err)
+	}
+
+	log.Info().
+		Str("audit", "emergency_access_used").
+		Int("user_id", ownerID).
+		Int("contact_id", userID).
+		Str("method", method).
+		Msg("emergency access used")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package proto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grantedEmergencyAccess grants emergency access to every vault.
+type grantedEmergencyAccess struct {
+	services.EmergencyAccessManagerInterface
+}
+
+func (grantedEmergencyAccess) AuthorizeEmergencyAccess(int, int) error {
+	return nil
+}
+
+func TestAuthorizeEmergencyAccess(t *testing.T) {
+	interceptor := &AuthInterceptor{emergencyAccess: grantedEmergencyAccess{}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(EmergencyOwnerKey, "2"))
+
+	tests := []struct {
+		method string
+		want   codes.Code
+	}{
+		{method: "/Secrets/GetSecret", want: codes.OK},
+		{method: "/Secrets/DownloadFile", want: codes.OK},
+		{method: "/Secrets/GetTOTPCode", want: codes.OK},
+		{method: "/Secrets/ListExpiring", want: codes.OK},
+		{method: "/Secrets/FindByURL", want: codes.OK},
+		{method: "/Secrets/SecurityReport", want: codes.OK},
+		{method: "/Secrets/UploadFile", want: codes.InvalidArgument},
+		{method: "/Secrets/ImportTOTP", want: codes.InvalidArgument},
+		{method: "/Secrets/PutSecret", want: codes.InvalidArgument},
+		{method: "/Secrets/DeleteVaultKey", want: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			err := interceptor.authorizeVault(ctx, tt.method, 1)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("authorizeVault(%s) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type AuditManagerInterface interface {
+	RecordEvent(event models.AuditEvent)
+	ListAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, int64, error)
+}
+
+// AuditManager records actions of users in the append-only audit log and lets users review their own events.
+type AuditManager struct {
+	auditRepo storage.AuditStorage
+}
+
+func NewAuditService(auditStorage storage.AuditStorage) *AuditManager {
+	return &AuditManager{auditRepo: auditStorage}
+}
+
+// RecordEvent appends event to the audit log. Failure to record the event doesn't fail the action, it is only logged.
+func (a *AuditManager) RecordEvent(event models.AuditEvent) {
+	err := a.auditRepo.AddEvent(event)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int("user_id", event.UserID).
+			Str("action", event.Action.String()).
+			Str("method", event.Method).
+			Msg("cant record audit event")
+	}
+}
+
+// ListAuditEvents returns one page of audit events of the user, newest first,
+// and the cursor of the next page. Next cursor is 0 when there are no more pages.
+func (a *AuditManager) ListAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, int64, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, 0, NewValidationError("to", "end of time range must be after its start")
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+
+	events, err := a.auditRepo.ListEvents(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list audit events")
+		return nil, 0, err
+	}
+
+	if len(events) <= limit {
+		return events, 0, nil
+	}
+
+	events = events[:limit]
+	return events, events[limit-1].ID, nil
+}
@@ -47,3 +47,38 @@ func (a *AuthService) Login(username string, password string) (*models.User, err
 
 	return user, nil
 }
+
+// GetKDFParams returns parameters that client uses to derive vault key from master password.
+// They are generated on the first request of the user and never change after that.
+func (a *AuthService) GetKDFParams(userID int) (models.KDFParams, error) {
+	params, err := a.userStorage.GetKDFParams(userID)
+	if err != nil {
+		return params, fmt.Errorf("cannot get kdf params: %w", err)
+	}
+	if params.Salt != nil {
+		return params, nil
+	}
+
+	params, err = NewKDFParams(&TrulyRandomGenerator{})
+	if err != nil {
+		return params, fmt.Errorf("cannot generate kdf params: %w", err)
+	}
+
+	params, err = a.userStorage.SetKDFParams(userID, params)
+	if err != nil {
+		return params, fmt.Errorf("cannot save kdf params: %w", err)
+	}
+
+	return params, nil
+}
+
+// SetKDFKeyCheck saves key check that client uses to verify master password unless it is already set.
+// It returns parameters with the key check that is saved for the user.
+func (a *AuthService) SetKDFKeyCheck(userID int, keyCheck []byte) (models.KDFParams, error) {
+	params, err := a.userStorage.SetKDFKeyCheck(userID, keyCheck)
+	if err != nil {
+		return params, fmt.Errorf("cannot save kdf key check: %w", err)
+	}
+
+	return params, nil
+}
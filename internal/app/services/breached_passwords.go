@@ -0,0 +1,356 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1" //nolint:gosec // corpus of breached passwords is keyed by sha-1
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Index of breached passwords is a file with sorted fixed size records of sha-1 hash and count.
+// Header contains magic and offsets of records for every 2 byte prefix of hash, so lookup
+// reads the table once on open and then binary searches a small bucket on disk.
+const (
+	breachIndexMagic      = "PMBRIDX1"
+	breachIndexBuckets    = 1 << 16
+	breachIndexRecordSize = sha1.Size + 4
+	breachIndexHeaderSize = len(breachIndexMagic) + (breachIndexBuckets+1)*8
+
+	// hibpPrefixLength is length of hash prefix in names of files of range format.
+	hibpPrefixLength = 5
+)
+
+// ErrBreachIndexNotLoaded is returned when server was started without index of breached passwords.
+var ErrBreachIndexNotLoaded = errors.New("breached passwords index is not loaded")
+
+// ErrInvalidHashPrefix is returned when range of breached hashes is requested by something
+// other than hibpPrefixLength hex characters.
+var ErrInvalidHashPrefix = fmt.Errorf("hash prefix must be %d hex characters", hibpPrefixLength)
+
+// BreachedHash is a breached hash found by its prefix.
+type BreachedHash struct {
+	Suffix string // uppercase hex characters of hash after the prefix
+	Count  int
+}
+
+// BreachIndex looks up sha-1 hashes of passwords in the index built by BuildBreachIndex.
+type BreachIndex struct {
+	file    *os.File
+	buckets []uint64
+}
+
+// OpenBreachIndex opens index and reads its prefix table.
+func OpenBreachIndex(path string) (*BreachIndex, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, breachIndexHeaderSize)
+	if _, err = io.ReadFull(file, header); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("cant read breach index header: %w", err)
+	}
+	if string(header[:len(breachIndexMagic)]) != breachIndexMagic {
+		_ = file.Close()
+		return nil, fmt.Errorf("%s is not a breach index", path)
+	}
+
+	buckets := make([]uint64, breachIndexBuckets+1)
+	table := header[len(breachIndexMagic):]
+	for i := range buckets {
+		buckets[i] = binary.BigEndian.Uint64(table[i*8:])
+	}
+
+	return &BreachIndex{file: file, buckets: buckets}, nil
+}
+
+// Count returns how many times password was seen in breaches. Zero means it was not found.
+func (b *BreachIndex) Count(password string) (int, error) {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec
+	bucket := binary.BigEndian.Uint16(hash[:2])
+
+	low, high := b.buckets[bucket], b.buckets[bucket+1]
+	record := make([]byte, breachIndexRecordSize)
+	for low < high {
+		middle := low + (high-low)/2
+		offset := int64(breachIndexHeaderSize) + int64(middle)*breachIndexRecordSize
+		if _, err := b.file.ReadAt(record, offset); err != nil {
+			return 0, fmt.Errorf("cant read breach index: %w", err)
+		}
+
+		switch bytes.Compare(record[:sha1.Size], hash[:]) {
+		case 0:
+			return int(binary.BigEndian.Uint32(record[sha1.Size:])), nil
+		case -1:
+			low = middle + 1
+		default:
+			high = middle
+		}
+	}
+
+	return 0, nil
+}
+
+// Range returns hashes that start with prefix of hibpPrefixLength hex characters, like range api
+// of Have I Been Pwned does, so password can be checked without sending it or its hash.
+func (b *BreachIndex) Range(prefix string) ([]BreachedHash, error) {
+	if len(prefix) != hibpPrefixLength || !isHex(prefix) {
+		return nil, ErrInvalidHashPrefix
+	}
+	prefix = strings.ToUpper(prefix)
+
+	first, err := hex.DecodeString(prefix + strings.Repeat("0", sha1.Size*2-hibpPrefixLength))
+	if err != nil {
+		return nil, err
+	}
+	last, err := hex.DecodeString(prefix + strings.Repeat("F", sha1.Size*2-hibpPrefixLength))
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := binary.BigEndian.Uint16(first[:2])
+	low, err := b.search(b.buckets[bucket], b.buckets[bucket+1], func(hash []byte) bool {
+		return bytes.Compare(hash, first) >= 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	high, err := b.search(low, b.buckets[bucket+1], func(hash []byte) bool {
+		return bytes.Compare(hash, last) > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]byte, (high-low)*breachIndexRecordSize)
+	if _, err = b.file.ReadAt(records, int64(breachIndexHeaderSize)+int64(low)*breachIndexRecordSize); err != nil {
+		return nil, fmt.Errorf("cant read breach index: %w", err)
+	}
+
+	hashes := make([]BreachedHash, 0, high-low)
+	for offset := 0; offset < len(records); offset += breachIndexRecordSize {
+		record := records[offset : offset+breachIndexRecordSize]
+		hashes = append(hashes, BreachedHash{
+			Suffix: strings.ToUpper(hex.EncodeToString(record[:sha1.Size]))[hibpPrefixLength:],
+			Count:  int(binary.BigEndian.Uint32(record[sha1.Size:])),
+		})
+	}
+
+	return hashes, nil
+}
+
+// search returns index of the first record from low to high which hash matches, or high if there is none.
+// Records must match from some index to the end, like in sort.Search.
+func (b *BreachIndex) search(low, high uint64, match func(hash []byte) bool) (uint64, error) {
+	record := make([]byte, breachIndexRecordSize)
+	for low < high {
+		middle := low + (high-low)/2
+		offset := int64(breachIndexHeaderSize) + int64(middle)*breachIndexRecordSize
+		if _, err := b.file.ReadAt(record, offset); err != nil {
+			return 0, fmt.Errorf("cant read breach index: %w", err)
+		}
+
+		if match(record[:sha1.Size]) {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	return low, nil
+}
+
+func (b *BreachIndex) Close() error {
+	return b.file.Close()
+}
+
+// BuildBreachIndex builds index from Have I Been Pwned corpus at source.
+// Source is either a file with "HASH:COUNT" lines ordered by hash, as produced by the
+// pwned passwords downloader, or a directory of range files named by 5 character
+// hash prefix with "SUFFIX:COUNT" lines.
+// Index is written to a temporary file and renamed to indexPath when it is complete.
+func BuildBreachIndex(source string, indexPath string) error {
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(indexPath), filepath.Base(indexPath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	builder := &breachIndexBuilder{
+		writer:  bufio.NewWriter(tmp),
+		buckets: make([]uint64, breachIndexBuckets+1),
+	}
+	if _, err = builder.writer.Write(make([]byte, breachIndexHeaderSize)); err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		err = builder.addRangeDirectory(source)
+	} else {
+		err = builder.addFile(source, "")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = builder.writeHeader(tmp); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), indexPath)
+}
+
+type breachIndexBuilder struct {
+	writer   *bufio.Writer
+	previous []byte
+	records  uint64
+	// buckets holds count of records per prefix until header is written
+	buckets []uint64
+}
+
+func (b *breachIndexBuilder) addRangeDirectory(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	// range files are added in order of their prefixes, so uppercase names to sort them as hashes
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToUpper(entries[i].Name()) < strings.ToUpper(entries[j].Name())
+	})
+	for _, entry := range entries {
+		prefix := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if entry.IsDir() || len(prefix) != hibpPrefixLength || !isHex(prefix) {
+			continue
+		}
+
+		if err = b.addFile(filepath.Join(dir, entry.Name()), prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addFile adds records from file. Lines of range files contain only suffix of hash after the prefix.
+func (b *breachIndexBuilder) addFile(path string, prefix string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		hash, count, err := parseHIBPLine(prefix + line)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+		// range api pads responses with fake hashes with zero count
+		if count == 0 {
+			continue
+		}
+
+		if err = b.add(hash, count); err != nil {
+			return fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (b *breachIndexBuilder) add(hash []byte, count uint32) error {
+	if b.previous != nil && bytes.Compare(b.previous, hash) >= 0 {
+		return errors.New("corpus must be ordered by hash without duplicates")
+	}
+	b.previous = hash
+
+	if _, err := b.writer.Write(hash); err != nil {
+		return err
+	}
+	if err := binary.Write(b.writer, binary.BigEndian, count); err != nil {
+		return err
+	}
+
+	b.buckets[binary.BigEndian.Uint16(hash[:2])+1]++
+	b.records++
+
+	return nil
+}
+
+// writeHeader turns counts of records per bucket into offsets and writes them at the start of file.
+func (b *breachIndexBuilder) writeHeader(file *os.File) error {
+	if err := b.writer.Flush(); err != nil {
+		return err
+	}
+
+	header := make([]byte, breachIndexHeaderSize)
+	copy(header, breachIndexMagic)
+	table := header[len(breachIndexMagic):]
+	for i := 1; i < len(b.buckets); i++ {
+		b.buckets[i] += b.buckets[i-1]
+	}
+	for i, offset := range b.buckets {
+		binary.BigEndian.PutUint64(table[i*8:], offset)
+	}
+
+	_, err := file.WriteAt(header, 0)
+	return err
+}
+
+func parseHIBPLine(line string) ([]byte, uint32, error) {
+	hexHash, rawCount, found := strings.Cut(line, ":")
+	if !found {
+		return nil, 0, errors.New("expected HASH:COUNT")
+	}
+
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil || len(hash) != sha1.Size {
+		return nil, 0, fmt.Errorf("invalid sha-1 hash %q", hexHash)
+	}
+
+	count, err := strconv.ParseUint(rawCount, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid count %q", rawCount)
+	}
+	if count > math.MaxUint32 {
+		count = math.MaxUint32
+	}
+
+	return hash, uint32(count), nil
+}
+
+func isHex(value string) bool {
+	_, err := hex.DecodeString(value + strings.Repeat("0", len(value)%2))
+	return err == nil
+}
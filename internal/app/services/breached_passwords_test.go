@@ -0,0 +1,123 @@
+package services
+
+import (
+	"crypto/sha1" //nolint:gosec // corpus of breached passwords is keyed by sha-1
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// testBreachIndex builds index of breached passwords with their counts.
+func testBreachIndex(t *testing.T, breached map[string]int) *BreachIndex {
+	t.Helper()
+
+	lines := make([]string, 0, len(breached))
+	for password, count := range breached {
+		lines = append(lines, fmt.Sprintf("%s:%d", sha1Hex(password), count))
+	}
+	sort.Strings(lines)
+
+	dir := t.TempDir()
+	corpus := filepath.Join(dir, "pwned-passwords.txt")
+	if err := os.WriteFile(corpus, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatalf("cant write corpus: %v", err)
+	}
+
+	indexPath := filepath.Join(dir, "breaches.idx")
+	if err := BuildBreachIndex(corpus, indexPath); err != nil {
+		t.Fatalf("cant build breach index: %v", err)
+	}
+
+	index, err := OpenBreachIndex(indexPath)
+	if err != nil {
+		t.Fatalf("cant open breach index: %v", err)
+	}
+	t.Cleanup(func() { _ = index.Close() })
+
+	return index
+}
+
+func sha1Hex(password string) string {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func TestBreachIndexCount(t *testing.T) {
+	index := testBreachIndex(t, map[string]int{"password": 9545824, "123456": 37359195, "qwerty": 3912816})
+
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{password: "password", want: 9545824},
+		{password: "123456", want: 37359195},
+		{password: "qwerty", want: 3912816},
+		{password: "Password", want: 0},
+		{password: "correct-horse-battery-staple", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			count, err := index.Count(tt.password)
+			if err != nil {
+				t.Fatalf("cant look up password: %v", err)
+			}
+			if count != tt.want {
+				t.Errorf("Count(%s) = %d, want %d", tt.password, count, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreachIndexRange(t *testing.T) {
+	index := testBreachIndex(t, map[string]int{"password": 9545824, "123456": 37359195})
+	passwordHash := sha1Hex("password")
+
+	tests := []struct {
+		name    string
+		prefix  string
+		want    []BreachedHash
+		wantErr error
+	}{
+		{
+			name:   "breached prefix",
+			prefix: passwordHash[:hibpPrefixLength],
+			want:   []BreachedHash{{Suffix: passwordHash[hibpPrefixLength:], Count: 9545824}},
+		},
+		{
+			name:   "lowercase prefix",
+			prefix: strings.ToLower(passwordHash[:hibpPrefixLength]),
+			want:   []BreachedHash{{Suffix: passwordHash[hibpPrefixLength:], Count: 9545824}},
+		},
+		{
+			name:   "prefix without breaches",
+			prefix: "00000",
+			want:   []BreachedHash{},
+		},
+		{
+			name:    "short prefix",
+			prefix:  passwordHash[:hibpPrefixLength-1],
+			wantErr: ErrInvalidHashPrefix,
+		},
+		{
+			name:    "not hex prefix",
+			prefix:  "XYZXY",
+			wantErr: ErrInvalidHashPrefix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hashes, err := index.Range(tt.prefix)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Range(%s) error = %v, want %v", tt.prefix, err, tt.wantErr)
+			}
+			if fmt.Sprint(hashes) != fmt.Sprint(tt.want) {
+				t.Errorf("Range(%s) = %v, want %v", tt.prefix, hashes, tt.want)
+			}
+		})
+	}
+}
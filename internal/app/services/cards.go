@@ -0,0 +1,209 @@
+package services
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+const (
+	minCardNumberLength = 12
+	maxCardNumberLength = 19
+
+	cardExpiryLayout     = "01/06"
+	cardLongExpiryLayout = "01/2006"
+)
+
+// Card brands detected by card number.
+const (
+	CardBrandVisa       = "Visa"
+	CardBrandMastercard = "Mastercard"
+	CardBrandAmex       = "American Express"
+	CardBrandDiscover   = "Discover"
+	CardBrandJCB        = "JCB"
+	CardBrandDiners     = "Diners Club"
+	CardBrandUnionPay   = "UnionPay"
+	CardBrandMaestro    = "Maestro"
+	CardBrandMir        = "Mir"
+	CardBrandUnknown    = "Unknown"
+)
+
+// cardBrandRanges maps brands to ranges of number prefixes. Ranges are checked in order.
+//
+//nolint:gochecknoglobals
+var cardBrandRanges = []struct {
+	brand    string
+	from, to int
+	digits   int
+}{
+	{brand: CardBrandAmex, from: 34, to: 34, digits: 2},
+	{brand: CardBrandAmex, from: 37, to: 37, digits: 2},
+	{brand: CardBrandDiners, from: 300, to: 305, digits: 3},
+	{brand: CardBrandDiners, from: 36, to: 36, digits: 2},
+	{brand: CardBrandDiners, from: 38, to: 39, digits: 2},
+	{brand: CardBrandJCB, from: 3528, to: 3589, digits: 4},
+	{brand: CardBrandVisa, from: 4, to: 4, digits: 1},
+	{brand: CardBrandMastercard, from: 51, to: 55, digits: 2},
+	{brand: CardBrandMastercard, from: 2221, to: 2720, digits: 4},
+	{brand: CardBrandMir, from: 2200, to: 2204, digits: 4},
+	{brand: CardBrandDiscover, from: 6011, to: 6011, digits: 4},
+	{brand: CardBrandDiscover, from: 644, to: 649, digits: 3},
+	{brand: CardBrandDiscover, from: 65, to: 65, digits: 2},
+	{brand: CardBrandUnionPay, from: 62, to: 62, digits: 2},
+	{brand: CardBrandMaestro, from: 50, to: 50, digits: 2},
+	{brand: CardBrandMaestro, from: 56, to: 69, digits: 2},
+}
+
+// CardValidator checks card number with the Luhn algorithm, expiry date and CCV.
+// It normalizes number and date of the card and sets its brand.
+// Expired card is rejected only when its number or expiry date is changed, so expired cards can still be edited.
+type CardValidator struct {
+	// current returns stored card that is updated
+	current func(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error)
+}
+
+func NewCardValidator(current func(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error)) CardValidator {
+	return CardValidator{current: current}
+}
+
+func (v CardValidator) Validate(secret models.Secret, metadata models.SecretMetadata) error {
+	card, ok := secret.(*models.CardSecret)
+	if !ok {
+		return nil
+	}
+
+	number := normalizeCardNumber(card.Number)
+	if len(number) < minCardNumberLength || len(number) > maxCardNumberLength || !isDigits(number) {
+		return NewValidationError("number", "card number must contain from 12 to 19 digits")
+	}
+	if !luhnValid(number) {
+		return NewValidationError("number", "card number is invalid")
+	}
+
+	expiry, err := parseCardExpiry(card.Date)
+	if err != nil {
+		return NewValidationError("date", "expiry date must be in format MM/YY")
+	}
+
+	// card is valid until the end of its expiry month
+	if !time.Now().Before(expiry.AddDate(0, 1, 0)) {
+		changed, err := v.changesCard(metadata, number, expiry)
+		if err != nil {
+			return err
+		}
+		if changed {
+			return NewValidationError("date", "card is expired")
+		}
+	}
+
+	brand := DetectCardBrand(number)
+	if card.CCV != "" {
+		ccvLength := 3
+		if brand == CardBrandAmex {
+			ccvLength = 4
+		}
+		if len(card.CCV) != ccvLength || !isDigits(card.CCV) {
+			return NewValidationError("ccv", "ccv is invalid")
+		}
+	}
+
+	card.Number = number
+	card.Date = expiry.Format(cardExpiryLayout)
+	card.Brand = brand
+
+	return nil
+}
+
+// changesCard tells if the card is new or its number or expiry date differ from the stored card.
+func (v CardValidator) changesCard(metadata models.SecretMetadata, number string, expiry time.Time) (bool, error) {
+	if metadata.Version == 0 || v.current == nil {
+		return true, nil
+	}
+
+	secret, _, err := v.current(metadata)
+	if err != nil {
+		return false, err
+	}
+
+	stored, ok := secret.(*models.CardSecret)
+	if !ok {
+		return true, nil
+	}
+
+	// stored date that can't be parsed is changed by any valid date
+	storedExpiry, parseErr := parseCardExpiry(stored.Date)
+	if parseErr != nil {
+		return true, nil
+	}
+
+	return normalizeCardNumber(stored.Number) != number || !storedExpiry.Equal(expiry), nil
+}
+
+// normalizeCardNumber removes spaces and dashes from card number.
+func normalizeCardNumber(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, number)
+}
+
+// DetectCardBrand returns brand of the card by its number.
+func DetectCardBrand(number string) string {
+	for _, brandRange := range cardBrandRanges {
+		if len(number) < brandRange.digits {
+			continue
+		}
+
+		prefix := 0
+		for _, digit := range number[:brandRange.digits] {
+			prefix = prefix*10 + int(digit-'0')
+		}
+		if prefix >= brandRange.from && prefix <= brandRange.to {
+			return brandRange.brand
+		}
+	}
+
+	return CardBrandUnknown
+}
+
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func parseCardExpiry(date string) (time.Time, error) {
+	date = strings.ReplaceAll(strings.TrimSpace(date), " ", "")
+
+	expiry, err := time.Parse(cardExpiryLayout, date)
+	if err == nil {
+		return expiry, nil
+	}
+
+	return time.Parse(cardLongExpiryLayout, date)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if !unicode.IsDigit(r) || r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return value != ""
+}
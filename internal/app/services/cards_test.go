@@ -0,0 +1,54 @@
+package services
+
+import "testing"
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		number string
+		want   bool
+	}{
+		{number: "4111111111111111", want: true},
+		{number: "4111111111111112", want: false},
+		{number: "378282246310005", want: true},
+		{number: "5555555555554444", want: true},
+		{number: "6011111111111117", want: true},
+		{number: "79927398713", want: true},
+		{number: "79927398710", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			if got := luhnValid(tt.number); got != tt.want {
+				t.Errorf("luhnValid(%s) = %v, want %v", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectCardBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "4111111111111111", want: CardBrandVisa},
+		{number: "5555555555554444", want: CardBrandMastercard},
+		{number: "2221000000000009", want: CardBrandMastercard},
+		{number: "378282246310005", want: CardBrandAmex},
+		{number: "341111111111111", want: CardBrandAmex},
+		{number: "6011111111111117", want: CardBrandDiscover},
+		{number: "6500000000000002", want: CardBrandDiscover},
+		{number: "3530111333300000", want: CardBrandJCB},
+		{number: "30569309025904", want: CardBrandDiners},
+		{number: "6200000000000005", want: CardBrandUnionPay},
+		{number: "2200000000000004", want: CardBrandMir},
+		{number: "6759649826438453", want: CardBrandMaestro},
+		{number: "9999999999999995", want: CardBrandUnknown},
+		{number: "", want: CardBrandUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			if got := DetectCardBrand(tt.number); got != tt.want {
+				t.Errorf("DetectCardBrand(%s) = %s, want %s", tt.number, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// dataKeySize is the size of AES-256 data keys
+const dataKeySize = 32
+
+// KeyRing gives cryptographers that encrypt secrets with data keys of vaults.
+type KeyRing interface {
+	// VaultCryptographer returns id and cryptographer of data key of personal vault of userID
+	// or of collection if collectionID is not zero. Data key is created on the first use.
+	VaultCryptographer(userID int, collectionID int64) (int64, Cryptographer, error)
+	// DataKeyCryptographer returns cryptographer of data key by its id.
+	// Zero id returns master key of the server that encrypts secrets saved before data keys were introduced.
+	DataKeyCryptographer(id int64) (Cryptographer, error)
+	// RetireVaultKey retires current data key of the vault, the next secret of the vault gets new data key.
+	RetireVaultKey(userID int, collectionID int64) error
+	// DeleteVaultKeys deletes all data keys of the vault, so secrets of the vault can't be decrypted anymore.
+	DeleteVaultKeys(userID int, collectionID int64) error
+}
+
+// DataKeysManager implements envelope encryption. Every vault has its own random data key that is stored
+// wrapped with the master key, so compromise of a data key exposes only one vault,
+// and deleting the data key makes secrets of the vault unreadable.
+type DataKeysManager struct {
+	keysRepo  storage.DataKeysStorage
+	masterKey Cryptographer
+	random    Generator
+}
+
+func NewDataKeysService(keysStorage storage.DataKeysStorage, masterKey Cryptographer, random Generator) *DataKeysManager {
+	return &DataKeysManager{
+		keysRepo:  keysStorage,
+		masterKey: masterKey,
+		random:    random,
+	}
+}
+
+func (k *DataKeysManager) VaultCryptographer(userID int, collectionID int64) (int64, Cryptographer, error) {
+	key, err := k.keysRepo.FindVaultKey(userID, collectionID)
+
+	var notFoundErr *storage.DataKeyNotFoundError
+	if errors.As(err, &notFoundErr) {
+		key, err = k.createVaultKey(userID, collectionID)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("cant get data key of vault")
+		return 0, nil, err
+	}
+
+	cryptographer, err := k.unwrap(key)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return key.ID, cryptographer, nil
+}
+
+func (k *DataKeysManager) DataKeyCryptographer(id int64) (Cryptographer, error) {
+	if id == 0 {
+		return k.masterKey, nil
+	}
+
+	key, err := k.keysRepo.FindDataKey(id)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get data key")
+		return nil, err
+	}
+
+	return k.unwrap(key)
+}
+
+// createVaultKey generates new data key and saves it wrapped with the master key.
+func (k *DataKeysManager) createVaultKey(userID int, collectionID int64) (models.DataKey, error) {
+	dataKey, err := k.random.GenerateRandomBytes(dataKeySize)
+	if err != nil {
+		return models.DataKey{}, err
+	}
+
+	wrappedKey, err := k.masterKey.Encrypt(dataKey)
+	if err != nil {
+		return models.DataKey{}, err
+	}
+
+	key := models.DataKey{WrappedKey: wrappedKey}
+	if collectionID != 0 {
+		key.CollectionID = collectionID
+	} else {
+		key.UserID = userID
+	}
+
+	return k.keysRepo.CreateVaultKey(key)
+}
+
+func (k *DataKeysManager) RetireVaultKey(userID int, collectionID int64) error {
+	err := k.keysRepo.RetireVaultKey(userID, collectionID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant retire data key of vault")
+		return err
+	}
+
+	return nil
+}
+
+func (k *DataKeysManager) DeleteVaultKeys(userID int, collectionID int64) error {
+	err := k.keysRepo.DeleteVaultKeys(userID, collectionID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant delete data keys of vault")
+		return err
+	}
+
+	return nil
+}
+
+// PurgeRetiredKeys deletes retired data keys after all secrets are reencrypted with current keys.
+func (k *DataKeysManager) PurgeRetiredKeys() error {
+	purged, err := k.keysRepo.DeleteUnusedRetiredKeys()
+	if err != nil {
+		log.Error().Err(err).Msg("cant purge retired data keys")
+		return err
+	}
+
+	if purged > 0 {
+		log.Info().Int64("purged", purged).Msg("purged retired data keys")
+	}
+
+	return nil
+}
+
+// RewrapDataKeys wraps data keys that are wrapped with previousMasterKey with the current master key,
+// so the master key of the server can be changed without reencrypting secrets.
+// Keys that are already wrapped with the current master key are skipped, so it is safe to run it again.
+func (k *DataKeysManager) RewrapDataKeys(previousMasterKey Cryptographer) error {
+	rewrapped, err := k.keysRepo.RewrapDataKeys(func(key models.DataKey) ([]byte, error) {
+		if _, err := k.masterKey.Decrypt(key.WrappedKey); err == nil {
+			return nil, nil
+		}
+
+		dataKey, err := previousMasterKey.Decrypt(key.WrappedKey)
+		if err != nil {
+			log.Error().Err(err).Int64("data_key_id", key.ID).Msg("cant unwrap data key with previous master key")
+			return nil, err
+		}
+
+		return k.masterKey.Encrypt(dataKey)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cant rewrap data keys")
+		return err
+	}
+
+	log.Info().Int64("rewrapped", rewrapped).Msg("rewrapped data keys with new master key")
+
+	return nil
+}
+
+// unwrap decrypts data key with the master key.
+func (k *DataKeysManager) unwrap(key models.DataKey) (Cryptographer, error) {
+	dataKey, err := k.masterKey.Decrypt(key.WrappedKey)
+	if err != nil {
+		log.Error().Err(err).Int64("data_key_id", key.ID).Msg("cant unwrap data key")
+		return nil, err
+	}
+
+	return &GCMAESCryptographer{Random: k.random, Key: dataKey}, nil
+}
+
+// RotateVaultKey retires current data key of the vault. Secrets of the vault are reencrypted
+// with new data key by ReencryptRetiredSecrets.
+func (s *SecretsManager) RotateVaultKey(userID int, collectionID int64) error {
+	return s.keys.RetireVaultKey(userID, collectionID)
+}
+
+// DeleteVaultKey crypto-shreds the vault: secrets of the vault, their revisions and files
+// can't be decrypted after data keys of the vault are deleted.
+func (s *SecretsManager) DeleteVaultKey(userID int, collectionID int64) error {
+	return s.keys.DeleteVaultKeys(userID, collectionID)
+}
+
+// ReencryptRetiredSecrets moves secrets that are encrypted with the master key or with retired data keys
+// to current data keys of their vaults, batchSize rows of every table at once, until none are left.
+// Rows that can't be decrypted are left as they are.
+func (s *SecretsManager) ReencryptRetiredSecrets(batchSize int) error {
+	var total int64
+	for {
+		processed, err := s.secretsRepo.ReencryptRetiredData(batchSize, func(vault models.SecretMetadata, dataKeyID int64, encryptedData []byte) ([]byte, int64, error) {
+			data, err := s.decrypt(encryptedData, false, dataKeyID)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return s.encrypt(data, vault)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("cant reencrypt retired secrets")
+			return err
+		}
+
+		total += processed
+		if processed == 0 {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Info().Int64("processed", total).Msg("reencrypted secrets with current data keys")
+	}
+
+	return nil
+}
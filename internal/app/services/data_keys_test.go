@@ -0,0 +1,190 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+)
+
+// memoryDataKeys keeps data keys in memory.
+type memoryDataKeys struct {
+	keys    map[int64]*models.DataKey
+	retired map[int64]bool
+	nextID  int64
+}
+
+func newMemoryDataKeys() *memoryDataKeys {
+	return &memoryDataKeys{keys: map[int64]*models.DataKey{}, retired: map[int64]bool{}}
+}
+
+func (m *memoryDataKeys) inVault(key *models.DataKey, userID int, collectionID int64) bool {
+	if collectionID != 0 {
+		return key.CollectionID == collectionID
+	}
+	return key.CollectionID == 0 && key.UserID == userID
+}
+
+func (m *memoryDataKeys) CreateVaultKey(key models.DataKey) (models.DataKey, error) {
+	if current, err := m.FindVaultKey(key.UserID, key.CollectionID); err == nil {
+		return current, nil
+	}
+	m.nextID++
+	key.ID = m.nextID
+	m.keys[key.ID] = &key
+	return key, nil
+}
+
+func (m *memoryDataKeys) FindVaultKey(userID int, collectionID int64) (models.DataKey, error) {
+	for id, key := range m.keys {
+		if !m.retired[id] && m.inVault(key, userID, collectionID) {
+			return *key, nil
+		}
+	}
+	return models.DataKey{}, storage.NewDataKeyNotFoundError(0, nil)
+}
+
+func (m *memoryDataKeys) FindDataKey(id int64) (models.DataKey, error) {
+	key, ok := m.keys[id]
+	if !ok {
+		return models.DataKey{}, storage.NewDataKeyNotFoundError(id, nil)
+	}
+	return *key, nil
+}
+
+func (m *memoryDataKeys) RetireVaultKey(userID int, collectionID int64) error {
+	for id, key := range m.keys {
+		if m.inVault(key, userID, collectionID) {
+			m.retired[id] = true
+		}
+	}
+	return nil
+}
+
+func (m *memoryDataKeys) DeleteVaultKeys(userID int, collectionID int64) error {
+	for id, key := range m.keys {
+		if m.inVault(key, userID, collectionID) {
+			delete(m.keys, id)
+		}
+	}
+	return nil
+}
+
+func (m *memoryDataKeys) DeleteUnusedRetiredKeys() (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) RewrapDataKeys(rewrap func(key models.DataKey) ([]byte, error)) (int64, error) {
+	var rewrapped int64
+	for _, key := range m.keys {
+		wrappedKey, err := rewrap(*key)
+		if err != nil {
+			return 0, err
+		}
+		if wrappedKey != nil {
+			key.WrappedKey = wrappedKey
+			rewrapped++
+		}
+	}
+	return rewrapped, nil
+}
+
+func masterKey(key string) Cryptographer {
+	return &GCMAESCryptographer{Random: &TrulyRandomGenerator{}, Key: []byte(key)}
+}
+
+func encryptInVault(t *testing.T, keys *DataKeysManager, userID int, data []byte) ([]byte, int64) {
+	t.Helper()
+
+	dataKeyID, cryptographer, err := keys.VaultCryptographer(userID, 0)
+	if err != nil {
+		t.Fatalf("cant get vault cryptographer: %v", err)
+	}
+	encrypted, err := cryptographer.Encrypt(data)
+	if err != nil {
+		t.Fatalf("cant encrypt: %v", err)
+	}
+
+	return encrypted, dataKeyID
+}
+
+func decryptWithKey(keys *DataKeysManager, dataKeyID int64, encrypted []byte) ([]byte, error) {
+	cryptographer, err := keys.DataKeyCryptographer(dataKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return cryptographer.Decrypt(encrypted)
+}
+
+func TestDeleteVaultKeysMakesDataUndecryptable(t *testing.T) {
+	keys := NewDataKeysService(newMemoryDataKeys(), masterKey("secret key secret key secret key"), &TrulyRandomGenerator{})
+	secret := []byte("my password")
+
+	encrypted, dataKeyID := encryptInVault(t, keys, 1, secret)
+	otherEncrypted, otherDataKeyID := encryptInVault(t, keys, 2, secret)
+
+	err := keys.DeleteVaultKeys(1, 0)
+	if err != nil {
+		t.Fatalf("cant delete vault keys: %v", err)
+	}
+
+	_, err = decryptWithKey(keys, dataKeyID, encrypted)
+	var notFoundErr *storage.DataKeyNotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Fatalf("expected data key not found error, got %v", err)
+	}
+
+	decrypted, err := decryptWithKey(keys, otherDataKeyID, otherEncrypted)
+	if err != nil || !bytes.Equal(decrypted, secret) {
+		t.Fatalf("other vault must stay readable, got %q, %v", decrypted, err)
+	}
+}
+
+func TestRetireVaultKeyKeepsOldDataReadable(t *testing.T) {
+	keys := NewDataKeysService(newMemoryDataKeys(), masterKey("secret key secret key secret key"), &TrulyRandomGenerator{})
+	secret := []byte("my password")
+
+	encrypted, oldKeyID := encryptInVault(t, keys, 1, secret)
+
+	err := keys.RetireVaultKey(1, 0)
+	if err != nil {
+		t.Fatalf("cant retire vault key: %v", err)
+	}
+
+	_, newKeyID := encryptInVault(t, keys, 1, secret)
+	if newKeyID == oldKeyID {
+		t.Fatalf("expected new data key after rotation, got %d", newKeyID)
+	}
+
+	decrypted, err := decryptWithKey(keys, oldKeyID, encrypted)
+	if err != nil || !bytes.Equal(decrypted, secret) {
+		t.Fatalf("data of retired key must stay readable until it is reencrypted, got %q, %v", decrypted, err)
+	}
+}
+
+func TestRewrapDataKeys(t *testing.T) {
+	repo := newMemoryDataKeys()
+	previousMasterKey := masterKey("previous key previous key previo")
+	secret := []byte("my password")
+
+	encrypted, dataKeyID := encryptInVault(t, NewDataKeysService(repo, previousMasterKey, &TrulyRandomGenerator{}), 1, secret)
+
+	keys := NewDataKeysService(repo, masterKey("secret key secret key secret key"), &TrulyRandomGenerator{})
+	if _, err := decryptWithKey(keys, dataKeyID, encrypted); err == nil {
+		t.Fatal("data key must not be unwrapped with new master key before rewrap")
+	}
+
+	for i := 0; i < 2; i++ {
+		err := keys.RewrapDataKeys(previousMasterKey)
+		if err != nil {
+			t.Fatalf("cant rewrap data keys: %v", err)
+		}
+	}
+
+	decrypted, err := decryptWithKey(keys, dataKeyID, encrypted)
+	if err != nil || !bytes.Equal(decrypted, secret) {
+		t.Fatalf("data must be readable with rewrapped key, got %q, %v", decrypted, err)
+	}
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type EmergencyAccessManagerInterface interface {
+	NominateContact(ownerID int, username string, waitPeriod time.Duration) error
+	RevokeContact(ownerID int, username string) error
+	ListContacts(ownerID int) ([]models.EmergencyContact, error)
+	ListGrantors(contactID int) ([]models.EmergencyContact, error)
+	RequestAccess(contactID int, ownerName string) (*models.EmergencyContact, error)
+	ApproveRequest(ownerID int, username string) error
+	RejectRequest(ownerID int, username string) error
+	AuthorizeEmergencyAccess(contactID int, ownerID int) error
+}
+
+const (
+	minEmergencyWaitPeriod = time.Hour
+	maxEmergencyWaitPeriod = 90 * 24 * time.Hour
+)
+
+// ErrNoEmergencyRequest is returned when owner approves or rejects access that contact didn't request
+var ErrNoEmergencyRequest = errors.New("emergency access is not requested")
+
+// EmergencyAccessManager manages emergency contacts. Contact gets read-only access to the vault of the owner
+// when owner approves the request or doesn't reject it within the wait period.
+type EmergencyAccessManager struct {
+	emergencyRepo storage.EmergencyAccessStorage
+}
+
+func NewEmergencyAccessService(emergencyStorage storage.EmergencyAccessStorage) *EmergencyAccessManager {
+	return &EmergencyAccessManager{emergencyRepo: emergencyStorage}
+}
+
+// NominateContact makes user with username an emergency contact of the owner.
+// Nominating the same user again changes the wait period.
+func (e *EmergencyAccessManager) NominateContact(ownerID int, username string, waitPeriod time.Duration) error {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return NewValidationError("username", "username is required")
+	}
+	if waitPeriod < minEmergencyWaitPeriod || waitPeriod > maxEmergencyWaitPeriod {
+		return NewValidationError("wait_period", "wait period must be from 1 hour to 90 days")
+	}
+
+	err := e.emergencyRepo.NominateContact(ownerID, username, waitPeriod)
+	if err != nil {
+		log.Error().Err(err).Msg("cant nominate emergency contact")
+		return err
+	}
+
+	return nil
+}
+
+// RevokeContact removes emergency contact of the owner together with its access.
+func (e *EmergencyAccessManager) RevokeContact(ownerID int, username string) error {
+	contact, err := e.emergencyRepo.FindContact(ownerID, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+
+	err = e.emergencyRepo.RemoveContact(ownerID, contact.ContactID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant remove emergency contact")
+		return err
+	}
+
+	return nil
+}
+
+// ListContacts returns emergency contacts of the owner.
+func (e *EmergencyAccessManager) ListContacts(ownerID int) ([]models.EmergencyContact, error) {
+	contacts, err := e.emergencyRepo.ListContacts(ownerID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list emergency contacts")
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// ListGrantors returns owners that nominated the user as emergency contact.
+func (e *EmergencyAccessManager) ListGrantors(contactID int) ([]models.EmergencyContact, error) {
+	grantors, err := e.emergencyRepo.ListGrantors(contactID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list emergency grantors")
+		return nil, err
+	}
+
+	return grantors, nil
+}
+
+// RequestAccess starts the wait period after which contact gets access to the vault of the owner.
+// Pending and approved requests are returned as they are.
+func (e *EmergencyAccessManager) RequestAccess(contactID int, ownerName string) (*models.EmergencyContact, error) {
+	contact, err := e.emergencyRepo.FindGrantor(contactID, strings.TrimSpace(ownerName))
+	if err != nil {
+		return nil, err
+	}
+	if contact.Status == models.EmergencyAccessRequested || contact.Status == models.EmergencyAccessApproved {
+		return contact, nil
+	}
+
+	err = e.emergencyRepo.UpdateContactStatus(contact.OwnerID, contactID, models.EmergencyAccessRequested)
+	if err != nil {
+		log.Error().Err(err).Msg("cant request emergency access")
+		return nil, err
+	}
+
+	log.Info().
+		Str("audit", "emergency_access_requested").
+		Int("user_id", contact.OwnerID).
+		Int("contact_id", contactID).
+		Msg("emergency access requested")
+
+	return e.emergencyRepo.FindEmergencyAccess(contact.OwnerID, contactID)
+}
+
+// ApproveRequest gives contact access without waiting for the end of the wait period.
+func (e *EmergencyAccessManager) ApproveRequest(ownerID int, username string) error {
+	contact, err := e.emergencyRepo.FindContact(ownerID, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+	if contact.Status != models.EmergencyAccessRequested {
+		return ErrNoEmergencyRequest
+	}
+
+	err = e.emergencyRepo.UpdateContactStatus(ownerID, contact.ContactID, models.EmergencyAccessApproved)
+	if err != nil {
+		log.Error().Err(err).Msg("cant approve emergency access")
+		return err
+	}
+
+	return nil
+}
+
+// RejectRequest rejects pending request or takes away access that contact already got.
+// Contact stays nominated and can request access again.
+func (e *EmergencyAccessManager) RejectRequest(ownerID int, username string) error {
+	contact, err := e.emergencyRepo.FindContact(ownerID, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+	if contact.Status != models.EmergencyAccessRequested && contact.Status != models.EmergencyAccessApproved {
+		return ErrNoEmergencyRequest
+	}
+
+	err = e.emergencyRepo.UpdateContactStatus(ownerID, contact.ContactID, models.EmergencyAccessRejected)
+	if err != nil {
+		log.Error().Err(err).Msg("cant reject emergency access")
+		return err
+	}
+
+	return nil
+}
+
+// AuthorizeEmergencyAccess checks that contact has access to the vault of the owner.
+func (e *EmergencyAccessManager) AuthorizeEmergencyAccess(contactID int, ownerID int) error {
+	contact, err := e.emergencyRepo.FindEmergencyAccess(ownerID, contactID)
+	var notFoundErr *storage.EmergencyContactNotFoundError
+	if errors.As(err, &notFoundErr) {
+		return ErrPermissionDenied
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("cant get emergency access")
+		return err
+	}
+	if !contact.AccessGranted(time.Now()) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
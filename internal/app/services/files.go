@@ -0,0 +1,170 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// FileChunkSize is the size of plaintext chunks that file content is split into before encryption.
+const FileChunkSize = 64 * 1024
+
+// SaveFile reads file content from r and saves it as file secret.
+// Content is encrypted with data key of the vault chunk by chunk, so the whole file is never kept in memory.
+func (s *SecretsManager) SaveFile(metadata models.SecretMetadata, fileName string, r io.Reader) (*models.BinarySecret, error) {
+	metadata.Type = models.SecretTypeBinary
+	metadata.Tags = normalizeTags(metadata.Tags)
+	if err := validateSecretName(metadata.Name); err != nil {
+		return nil, err
+	}
+	file := &models.BinarySecret{FileName: fileName}
+
+	dataKeyID, cryptographer, err := s.keys.VaultCryptographer(metadata.UserID, metadata.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+	metadata.DataKeyID = dataKeyID
+
+	err = s.secretsRepo.CreateFile(metadata, func(writeChunk storage.ChunkFunc) ([]byte, error) {
+		hash := sha256.New()
+		buf := make([]byte, FileChunkSize)
+
+		for {
+			n, readErr := io.ReadFull(r, buf)
+			if n > 0 {
+				hash.Write(buf[:n])
+				file.Size += int64(n)
+
+				encryptedChunk, err := cryptographer.Encrypt(buf[:n])
+				if err != nil {
+					return nil, err
+				}
+
+				err = writeChunk(encryptedChunk)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				break
+			}
+			if readErr != nil {
+				return nil, readErr
+			}
+		}
+
+		file.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+		encodedSecret, err := file.ToBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		return cryptographer.Encrypt(encodedSecret)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cant save file")
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// SaveEncryptedFile saves file secret that is encrypted by the client. Chunks from readChunk are stored as they are
+// until it returns io.EOF, then encryptedInfo returns name, size and checksum of the file encrypted by the client.
+func (s *SecretsManager) SaveEncryptedFile(
+	metadata models.SecretMetadata,
+	readChunk func() ([]byte, error),
+	encryptedInfo func() []byte,
+) error {
+	metadata.Type = models.SecretTypeBinary
+	metadata.Tags = normalizeTags(metadata.Tags)
+	metadata.ClientEncrypted = true
+	if err := validateSecretName(metadata.Name); err != nil {
+		return err
+	}
+
+	err := s.secretsRepo.CreateFile(metadata, func(writeChunk storage.ChunkFunc) ([]byte, error) {
+		for {
+			chunk, err := readChunk()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			err = writeChunk(chunk)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		info := encryptedInfo()
+		if len(info) == 0 {
+			return nil, NewValidationError("encrypted_info", "encrypted file info is required")
+		}
+
+		return info, nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cant save encrypted file")
+		return err
+	}
+
+	return nil
+}
+
+// GetFileInfo returns name, size and checksum of file secret without its content.
+// File encrypted by the client is returned as models.EncryptedSecret.
+func (s *SecretsManager) GetFileInfo(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error) {
+	metadata.Type = models.SecretTypeBinary
+	return s.decodeSecret(metadata)
+}
+
+// ReadFile decrypts content of file secret chunk by chunk and writes it to w.
+// Chunks of file encrypted by the client are written as they are stored, one chunk per write,
+// so metadata.ClientEncrypted must be set from metadata that is returned by GetFileInfo.
+func (s *SecretsManager) ReadFile(metadata models.SecretMetadata, w io.Writer) error {
+	metadata.Type = models.SecretTypeBinary
+
+	// chunks of one file are encrypted with the same key unless they are being reencrypted
+	var cryptographer Cryptographer
+	cryptographerKeyID := int64(-1)
+
+	err := s.secretsRepo.ReadFileChunks(metadata, func(encryptedChunk []byte, dataKeyID int64) error {
+		if metadata.ClientEncrypted {
+			_, err := w.Write(encryptedChunk)
+			return err
+		}
+
+		if dataKeyID != cryptographerKeyID {
+			var err error
+			cryptographer, err = s.keys.DataKeyCryptographer(dataKeyID)
+			if err != nil {
+				return err
+			}
+			cryptographerKeyID = dataKeyID
+		}
+
+		chunk, err := cryptographer.Decrypt(encryptedChunk)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(chunk)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cant read file")
+		return err
+	}
+
+	return nil
+}
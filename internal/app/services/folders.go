@@ -0,0 +1,179 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type FoldersManagerInterface interface {
+	CreateFolder(userID int, collectionID int64, path string) (string, error)
+	RenameFolder(userID int, collectionID int64, path string, newName string) (string, error)
+	MoveFolder(userID int, collectionID int64, path string, newParent string) (string, error)
+	DeleteFolder(userID int, collectionID int64, path string) error
+	ListFolder(userID int, collectionID int64, path string) (models.FolderContents, error)
+}
+
+// FoldersManager organizes secrets in folders by paths in their names.
+// Zero collectionID means personal vault of the user.
+type FoldersManager struct {
+	foldersRepo storage.FolderStorage
+}
+
+func NewFoldersService(foldersStorage storage.FolderStorage) *FoldersManager {
+	return &FoldersManager{foldersRepo: foldersStorage}
+}
+
+// CreateFolder creates empty folder and returns its clean path.
+func (f *FoldersManager) CreateFolder(userID int, collectionID int64, path string) (string, error) {
+	path, err := CleanPath(path)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", NewValidationError("path", "path is required")
+	}
+
+	err = f.foldersRepo.CreateFolder(userID, collectionID, path)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create folder")
+		return "", err
+	}
+
+	return path, nil
+}
+
+// RenameFolder changes the last element of folder path and returns the new path.
+func (f *FoldersManager) RenameFolder(userID int, collectionID int64, path string, newName string) (string, error) {
+	if newName == "" || strings.Contains(newName, models.PathSeparator) {
+		return "", NewValidationError("new_name", "name must be non-empty and must not contain "+models.PathSeparator)
+	}
+
+	path, err := CleanPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	return f.moveFolder(userID, collectionID, path, joinPath(parentPath(path), newName))
+}
+
+// MoveFolder moves folder with its contents into newParent and returns the new path.
+// Empty newParent moves folder to the root.
+func (f *FoldersManager) MoveFolder(userID int, collectionID int64, path string, newParent string) (string, error) {
+	path, err := CleanPath(path)
+	if err != nil {
+		return "", err
+	}
+	newParent, err = CleanPath(newParent)
+	if err != nil {
+		return "", err
+	}
+
+	return f.moveFolder(userID, collectionID, path, joinPath(newParent, lastPathElement(path)))
+}
+
+func (f *FoldersManager) moveFolder(userID int, collectionID int64, from string, to string) (string, error) {
+	to, err := CleanPath(to)
+	if err != nil {
+		return "", err
+	}
+	if from == "" {
+		return "", NewValidationError("path", "root folder can't be moved")
+	}
+	if from == to {
+		return to, nil
+	}
+	if strings.HasPrefix(to, from+models.PathSeparator) {
+		return "", NewValidationError("new_parent", "folder can't be moved into itself")
+	}
+
+	err = f.foldersRepo.MoveFolder(userID, collectionID, from, to)
+	if err != nil {
+		log.Error().Err(err).Msg("cant move folder")
+		return "", err
+	}
+
+	return to, nil
+}
+
+// DeleteFolder deletes folder that has no secrets.
+func (f *FoldersManager) DeleteFolder(userID int, collectionID int64, path string) error {
+	path, err := CleanPath(path)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return NewValidationError("path", "root folder can't be deleted")
+	}
+
+	err = f.foldersRepo.DeleteFolder(userID, collectionID, path)
+	if err != nil {
+		log.Error().Err(err).Msg("cant delete folder")
+		return err
+	}
+
+	return nil
+}
+
+// ListFolder returns subfolders and secrets of the folder. Empty path lists the root folder.
+func (f *FoldersManager) ListFolder(userID int, collectionID int64, path string) (models.FolderContents, error) {
+	path, err := CleanPath(path)
+	if err != nil {
+		return models.FolderContents{}, err
+	}
+
+	contents, err := f.foldersRepo.ListFolder(userID, collectionID, path)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list folder")
+		return contents, err
+	}
+
+	return contents, nil
+}
+
+// CleanPath trims spaces and separators around path and rejects empty, "." and ".." elements.
+// Empty path is the root folder.
+func CleanPath(path string) (string, error) {
+	path = strings.Trim(strings.TrimSpace(path), models.PathSeparator)
+	if path == "" {
+		return "", nil
+	}
+
+	for _, element := range strings.Split(path, models.PathSeparator) {
+		if element == "" || element == "." || element == ".." || strings.TrimSpace(element) != element {
+			return "", NewValidationError("path", "path must not contain empty, \".\" or \"..\" elements")
+		}
+	}
+
+	return path, nil
+}
+
+// validateSecretName checks that name of the new secret is a clean path.
+func validateSecretName(name string) error {
+	clean, err := CleanPath(name)
+	if err != nil || clean != name || name == "" {
+		return NewValidationError("name", "name must be a path like prod/db/postgres")
+	}
+	return nil
+}
+
+func joinPath(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + models.PathSeparator + name
+}
+
+func parentPath(path string) string {
+	i := strings.LastIndex(path, models.PathSeparator)
+	if i < 0 {
+		return ""
+	}
+	return path[:i]
+}
+
+func lastPathElement(path string) string {
+	return path[strings.LastIndex(path, models.PathSeparator)+1:]
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"golang.org/x/crypto/argon2"
+)
+
+// Default parameters of Argon2id, as recommended by RFC 9106 for memory constrained environments.
+const (
+	kdfSaltSize = 16
+	kdfTime     = 3
+	kdfMemory   = 64 * 1024
+	kdfThreads  = 4
+
+	// vaultKeySize is the size of AES-256 key
+	vaultKeySize = 32
+
+	// vaultKeyCheck is the known value that is encrypted with vault key to verify master password
+	vaultKeyCheck = "vault key check"
+)
+
+// NewKDFParams returns default parameters of the key derivation with new random salt.
+func NewKDFParams(random Generator) (models.KDFParams, error) {
+	salt, err := random.GenerateRandomBytes(kdfSaltSize)
+	if err != nil {
+		return models.KDFParams{}, err
+	}
+
+	return models.KDFParams{
+		Salt:    salt,
+		Time:    kdfTime,
+		Memory:  kdfMemory,
+		Threads: kdfThreads,
+	}, nil
+}
+
+// DeriveVaultKey derives the key that client uses to encrypt secrets from master password with Argon2id.
+// Master password never leaves the client, so the server can't decrypt secrets encrypted with this key.
+func DeriveVaultKey(masterPassword string, params models.KDFParams) []byte {
+	return argon2.IDKey([]byte(masterPassword), params.Salt, params.Time, params.Memory, params.Threads, vaultKeySize)
+}
+
+// NewVaultKeyCheck encrypts known value with vault key. It is stored with parameters of the key derivation,
+// so client verifies master password without sending it or the vault key to the server.
+func NewVaultKeyCheck(key []byte, random Generator) ([]byte, error) {
+	return (&GCMAESCryptographer{Random: random, Key: key}).Encrypt([]byte(vaultKeyCheck))
+}
+
+// VerifyVaultKey tells if keyCheck was made by NewVaultKeyCheck with the same key.
+func VerifyVaultKey(key []byte, keyCheck []byte) bool {
+	data, err := (&GCMAESCryptographer{Key: key}).Decrypt(keyCheck)
+	return err == nil && string(data) == vaultKeyCheck
+}
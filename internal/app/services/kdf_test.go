@@ -0,0 +1,32 @@
+package services
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVerifyVaultKey(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, vaultKeySize)
+	keyCheck, err := NewVaultKeyCheck(key, &TrulyRandomGenerator{})
+	if err != nil {
+		t.Fatalf("cant make vault key check: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		key      []byte
+		keyCheck []byte
+		want     bool
+	}{
+		{name: "same key", key: key, keyCheck: keyCheck, want: true},
+		{name: "other key", key: bytes.Repeat([]byte{2}, vaultKeySize), keyCheck: keyCheck, want: false},
+		{name: "empty key check", key: key, keyCheck: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyVaultKey(tt.key, tt.keyCheck); got != tt.want {
+				t.Errorf("VerifyVaultKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type NotificationsManagerInterface interface {
+	ListNotifications(userID int) ([]models.Notification, error)
+}
+
+// NotificationsManager notifies users about secrets that expire or need rotation.
+type NotificationsManager struct {
+	secretsRepo       storage.SecretsStorage
+	notificationsRepo storage.NotificationStorage
+}
+
+func NewNotificationsService(
+	secretsStorage storage.SecretsStorage,
+	notificationsStorage storage.NotificationStorage,
+) *NotificationsManager {
+	return &NotificationsManager{
+		secretsRepo:       secretsStorage,
+		notificationsRepo: notificationsStorage,
+	}
+}
+
+// NotifyDue records notifications for all secrets that are overdue or will be due within window.
+// Secret is notified once for every due date, so it is safe to call it periodically.
+func (n *NotificationsManager) NotifyDue(window time.Duration) error {
+	secrets, err := n.secretsRepo.ListDue(0, 0, time.Now().Add(window))
+	if err != nil {
+		log.Error().Err(err).Msg("cant list due secrets")
+		return err
+	}
+
+	notifications := make([]models.Notification, 0, len(secrets))
+	for _, secret := range secrets {
+		notifications = append(notifications, models.Notification{
+			UserID:     secret.Metadata.UserID,
+			SecretID:   secret.Metadata.ID,
+			SecretName: secret.Metadata.Name,
+			SecretType: secret.Metadata.Type,
+			Reason:     secret.Reason,
+			DueAt:      secret.DueAt,
+		})
+	}
+
+	created, err := n.notificationsRepo.CreateNotifications(notifications)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create notifications")
+		return err
+	}
+
+	log.Info().Msgf("created %d notifications about due secrets", created)
+	return nil
+}
+
+// ListNotifications returns unread notifications of the user. Returned notifications become read.
+func (n *NotificationsManager) ListNotifications(userID int) ([]models.Notification, error) {
+	notifications, err := n.notificationsRepo.TakeUnreadNotifications(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list notifications")
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type OrganizationsManagerInterface interface {
+	CreateOrganization(userID int, name string) (*models.Organization, error)
+	ListOrganizations(userID int) ([]models.Organization, error)
+	ListMembers(userID int, organizationID int64) ([]models.OrganizationMember, error)
+	AddMember(userID int, organizationID int64, username string, role models.OrganizationRole) error
+	UpdateMemberRole(userID int, organizationID int64, username string, role models.OrganizationRole) error
+	RemoveMember(userID int, organizationID int64, username string) error
+	CreateCollection(userID int, organizationID int64, name string) (*models.Collection, error)
+	ListCollections(userID int, organizationID int64) ([]models.Collection, error)
+	AuthorizeCollection(userID int, collectionID int64, role models.OrganizationRole) error
+}
+
+// ErrPermissionDenied is returned when role of the user in organization is too low for the action
+var ErrPermissionDenied = errors.New("permission denied")
+
+// OrganizationsManager manages organizations, their members and collections.
+// Members can't grant roles higher than their own or change members with higher roles.
+type OrganizationsManager struct {
+	organizationsRepo storage.OrganizationStorage
+}
+
+func NewOrganizationsService(organizationsStorage storage.OrganizationStorage) *OrganizationsManager {
+	return &OrganizationsManager{organizationsRepo: organizationsStorage}
+}
+
+// CreateOrganization creates organization with the user as its owner.
+func (o *OrganizationsManager) CreateOrganization(userID int, name string) (*models.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, NewValidationError("name", "name is required")
+	}
+
+	organization, err := o.organizationsRepo.CreateOrganization(name, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create organization")
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// ListOrganizations returns organizations that user is a member of.
+func (o *OrganizationsManager) ListOrganizations(userID int) ([]models.Organization, error) {
+	organizations, err := o.organizationsRepo.ListOrganizations(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list organizations")
+		return nil, err
+	}
+
+	return organizations, nil
+}
+
+// ListMembers returns members of organization. Any member can list them.
+func (o *OrganizationsManager) ListMembers(userID int, organizationID int64) ([]models.OrganizationMember, error) {
+	_, err := o.requireRole(userID, organizationID, models.RoleReadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := o.organizationsRepo.ListMembers(organizationID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list organization members")
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// AddMember adds user with username to organization. Only admins and owners can add members.
+func (o *OrganizationsManager) AddMember(userID int, organizationID int64, username string, role models.OrganizationRole) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	userRole, err := o.requireRole(userID, organizationID, models.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if role > userRole {
+		return ErrPermissionDenied
+	}
+
+	err = o.organizationsRepo.AddMember(organizationID, strings.TrimSpace(username), role)
+	if err != nil {
+		log.Error().Err(err).Msg("cant add organization member")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateMemberRole changes role of the member. Only admins and owners can change roles.
+func (o *OrganizationsManager) UpdateMemberRole(userID int, organizationID int64, username string, role models.OrganizationRole) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	userRole, err := o.requireRole(userID, organizationID, models.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	member, err := o.organizationsRepo.FindMember(organizationID, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+	if role > userRole || member.Role > userRole {
+		return ErrPermissionDenied
+	}
+
+	err = o.organizationsRepo.UpdateMemberRole(organizationID, member.UserID, role)
+	if err != nil {
+		log.Error().Err(err).Msg("cant update organization member role")
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember removes member from organization. Any member can leave organization,
+// other members can be removed only by admins and owners.
+func (o *OrganizationsManager) RemoveMember(userID int, organizationID int64, username string) error {
+	userRole, err := o.requireRole(userID, organizationID, models.RoleReadOnly)
+	if err != nil {
+		return err
+	}
+
+	member, err := o.organizationsRepo.FindMember(organizationID, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+	if member.UserID != userID && (userRole < models.RoleAdmin || member.Role > userRole) {
+		return ErrPermissionDenied
+	}
+
+	err = o.organizationsRepo.RemoveMember(organizationID, member.UserID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant remove organization member")
+		return err
+	}
+
+	return nil
+}
+
+// CreateCollection creates collection of secrets in organization. Only admins and owners can create collections.
+func (o *OrganizationsManager) CreateCollection(userID int, organizationID int64, name string) (*models.Collection, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, NewValidationError("name", "name is required")
+	}
+
+	_, err := o.requireRole(userID, organizationID, models.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := o.organizationsRepo.CreateCollection(organizationID, name)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create collection")
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// ListCollections returns collections of organization. Any member can list them.
+func (o *OrganizationsManager) ListCollections(userID int, organizationID int64) ([]models.Collection, error) {
+	_, err := o.requireRole(userID, organizationID, models.RoleReadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := o.organizationsRepo.ListCollections(organizationID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list collections")
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+// AuthorizeCollection checks that user has at least given role in organization of the collection.
+func (o *OrganizationsManager) AuthorizeCollection(userID int, collectionID int64, role models.OrganizationRole) error {
+	userRole, err := o.organizationsRepo.FindCollectionRole(collectionID, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get role of user in collection")
+		return err
+	}
+	if userRole < role {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// requireRole returns role of the user in organization if it is at least given role.
+func (o *OrganizationsManager) requireRole(userID int, organizationID int64, role models.OrganizationRole) (models.OrganizationRole, error) {
+	userRole, err := o.organizationsRepo.FindRole(organizationID, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get role of user in organization")
+		return 0, err
+	}
+	if userRole < role {
+		return 0, ErrPermissionDenied
+	}
+
+	return userRole, nil
+}
+
+func validateRole(role models.OrganizationRole) error {
+	if role < models.RoleReadOnly || role > models.RoleOwner {
+		return NewValidationError("role", "role must be owner, admin, member or read-only")
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Character classes of generated passwords.
+const (
+	lowerLetters    = "abcdefghijklmnopqrstuvwxyz"
+	upperLetters    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitCharacters = "0123456789"
+	symbols         = "!@#$%^&*()-_=+[]{};:,.<>/?~"
+
+	// ambiguousCharacters look alike in many fonts
+	ambiguousCharacters = "Il1O0o|`'\""
+)
+
+const (
+	DefaultGeneratedPasswordLength = 20
+	MinGeneratedPasswordLength     = 8
+	MaxGeneratedPasswordLength     = 128
+
+	DefaultPassphraseWords   = 6
+	MinPassphraseWords       = 3
+	MaxPassphraseWords       = 20
+	DefaultPassphraseDivider = "-"
+)
+
+// effWordList is the EFF large wordlist for diceware passphrases, each line is a dice roll and a word.
+// https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases, licensed under CC BY 3.0 US.
+//
+//go:embed dictionaries/eff_large_wordlist.txt
+var effWordList string
+
+//nolint:gochecknoglobals
+var passphraseWords = parseWordList(effWordList)
+
+// GenerationPolicy describes password that should be generated.
+// Min counts of disabled classes must be zero.
+type GenerationPolicy struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	MinLower         int
+	MinUpper         int
+	MinDigits        int
+	MinSymbols       int
+	ExcludeAmbiguous bool
+}
+
+// DefaultGenerationPolicy returns policy of password with all character classes.
+func DefaultGenerationPolicy() GenerationPolicy {
+	return GenerationPolicy{
+		Length:  DefaultGeneratedPasswordLength,
+		Lower:   true,
+		Upper:   true,
+		Digits:  true,
+		Symbols: true,
+	}
+}
+
+// PassphrasePolicy describes diceware passphrase that should be generated.
+type PassphrasePolicy struct {
+	Divider       string
+	Words         int
+	Capitalize    bool
+	IncludeNumber bool // appends random digit to one of the words
+}
+
+// PasswordGenerator generates passwords and passphrases with random values of Generator.
+type PasswordGenerator struct {
+	Random Generator
+}
+
+func NewPasswordGenerator(random Generator) *PasswordGenerator {
+	return &PasswordGenerator{Random: random}
+}
+
+// GeneratePassword generates password that satisfies policy. Characters are chosen uniformly from enabled classes,
+// then minimal counts of classes are guaranteed by placing required characters at random positions.
+func (g *PasswordGenerator) GeneratePassword(policy GenerationPolicy) (string, error) {
+	classes, err := passwordClasses(policy)
+	if err != nil {
+		return "", err
+	}
+
+	password := make([]byte, 0, policy.Length)
+	alphabet := ""
+	for _, class := range classes {
+		alphabet += class.characters
+		for i := 0; i < class.min; i++ {
+			var c byte
+			c, err = g.randomCharacter(class.characters)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+	}
+
+	for len(password) < policy.Length {
+		var c byte
+		c, err = g.randomCharacter(alphabet)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Fisher-Yates shuffle, so required characters are not grouped at the beginning
+	for i := len(password) - 1; i > 0; i-- {
+		var j int
+		j, err = g.randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// GeneratePassphrase generates passphrase of words chosen uniformly from the EFF large wordlist.
+// Every word adds about 12.9 bits of entropy.
+func (g *PasswordGenerator) GeneratePassphrase(policy PassphrasePolicy) (string, error) {
+	if policy.Words < MinPassphraseWords || policy.Words > MaxPassphraseWords {
+		return "", NewValidationError(
+			"words",
+			fmt.Sprintf("passphrase must have from %d to %d words", MinPassphraseWords, MaxPassphraseWords),
+		)
+	}
+
+	words := make([]string, policy.Words)
+	for i := range words {
+		index, err := g.randomInt(len(passphraseWords))
+		if err != nil {
+			return "", err
+		}
+
+		words[i] = passphraseWords[index]
+		if policy.Capitalize {
+			runes := []rune(words[i])
+			runes[0] = unicode.ToUpper(runes[0])
+			words[i] = string(runes)
+		}
+	}
+
+	if policy.IncludeNumber {
+		wordIndex, err := g.randomInt(len(words))
+		if err != nil {
+			return "", err
+		}
+		digit, err := g.randomCharacter(digitCharacters)
+		if err != nil {
+			return "", err
+		}
+		words[wordIndex] += string(digit)
+	}
+
+	return strings.Join(words, policy.Divider), nil
+}
+
+type characterClass struct {
+	characters string
+	min        int
+}
+
+func passwordClasses(policy GenerationPolicy) ([]characterClass, error) {
+	if policy.Length < MinGeneratedPasswordLength || policy.Length > MaxGeneratedPasswordLength {
+		return nil, NewValidationError(
+			"length",
+			fmt.Sprintf("length must be from %d to %d", MinGeneratedPasswordLength, MaxGeneratedPasswordLength),
+		)
+	}
+
+	candidates := []struct {
+		name       string
+		characters string
+		enabled    bool
+		min        int
+	}{
+		{name: "lower", characters: lowerLetters, enabled: policy.Lower, min: policy.MinLower},
+		{name: "upper", characters: upperLetters, enabled: policy.Upper, min: policy.MinUpper},
+		{name: "digits", characters: digitCharacters, enabled: policy.Digits, min: policy.MinDigits},
+		{name: "symbols", characters: symbols, enabled: policy.Symbols, min: policy.MinSymbols},
+	}
+
+	var classes []characterClass
+	required := 0
+	for _, candidate := range candidates {
+		if candidate.min < 0 {
+			return nil, NewValidationError(candidate.name, "minimal count must not be negative")
+		}
+		if !candidate.enabled {
+			if candidate.min > 0 {
+				return nil, NewValidationError(candidate.name, "minimal count is set for disabled class")
+			}
+			continue
+		}
+
+		characters := candidate.characters
+		if policy.ExcludeAmbiguous {
+			characters = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguousCharacters, r) {
+					return -1
+				}
+				return r
+			}, characters)
+		}
+
+		classes = append(classes, characterClass{characters: characters, min: candidate.min})
+		required += candidate.min
+	}
+
+	if len(classes) == 0 {
+		return nil, NewValidationError("classes", "at least one character class must be enabled")
+	}
+	if required > policy.Length {
+		return nil, NewValidationError("length", "minimal counts of classes exceed length")
+	}
+
+	return classes, nil
+}
+
+func (g *PasswordGenerator) randomCharacter(alphabet string) (byte, error) {
+	i, err := g.randomInt(len(alphabet))
+	if err != nil {
+		return 0, err
+	}
+	return alphabet[i], nil
+}
+
+// randomInt returns uniformly distributed random number in [0, n).
+// Random values that would make distribution biased are rejected.
+func (g *PasswordGenerator) randomInt(n int) (int, error) {
+	limit := math.MaxUint32 - math.MaxUint32%uint32(n)
+	for {
+		b, err := g.Random.GenerateRandomBytes(4)
+		if err != nil {
+			return 0, err
+		}
+
+		value := binary.BigEndian.Uint32(b)
+		if value < limit {
+			return int(value % uint32(n)), nil
+		}
+	}
+}
+
+func parseWordList(list string) []string {
+	lines := strings.Split(strings.TrimSpace(list), "\n")
+	words := make([]string, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			words = append(words, fields[len(fields)-1])
+		}
+	}
+	return words
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	generator := NewPasswordGenerator(&TrulyRandomGenerator{})
+
+	tests := []struct {
+		name      string
+		policy    GenerationPolicy
+		alphabet  string
+		wantField string
+	}{
+		{
+			name:     "default policy",
+			policy:   DefaultGenerationPolicy(),
+			alphabet: lowerLetters + upperLetters + digitCharacters + symbols,
+		},
+		{
+			name:     "digits only",
+			policy:   GenerationPolicy{Length: MinGeneratedPasswordLength, Digits: true, MinDigits: MinGeneratedPasswordLength},
+			alphabet: digitCharacters,
+		},
+		{
+			name: "minimal counts",
+			policy: GenerationPolicy{
+				Length: 12, Lower: true, Upper: true, Digits: true, Symbols: true,
+				MinLower: 3, MinUpper: 3, MinDigits: 3, MinSymbols: 3,
+			},
+			alphabet: lowerLetters + upperLetters + digitCharacters + symbols,
+		},
+		{
+			name:     "exclude ambiguous",
+			policy:   GenerationPolicy{Length: MaxGeneratedPasswordLength, Lower: true, Upper: true, Digits: true, ExcludeAmbiguous: true},
+			alphabet: "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789",
+		},
+		{
+			name:      "too short",
+			policy:    GenerationPolicy{Length: MinGeneratedPasswordLength - 1, Lower: true},
+			wantField: "length",
+		},
+		{
+			name:      "too long",
+			policy:    GenerationPolicy{Length: MaxGeneratedPasswordLength + 1, Lower: true},
+			wantField: "length",
+		},
+		{
+			name:      "no classes",
+			policy:    GenerationPolicy{Length: DefaultGeneratedPasswordLength},
+			wantField: "classes",
+		},
+		{
+			name:      "minimal count of disabled class",
+			policy:    GenerationPolicy{Length: DefaultGeneratedPasswordLength, Lower: true, MinSymbols: 1},
+			wantField: "symbols",
+		},
+		{
+			name:      "minimal counts exceed length",
+			policy:    GenerationPolicy{Length: MinGeneratedPasswordLength, Lower: true, Upper: true, MinLower: 5, MinUpper: 5},
+			wantField: "length",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				password, err := generator.GeneratePassword(tt.policy)
+				if tt.wantField != "" {
+					var validationErr *ValidationError
+					if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+						t.Fatalf("expected validation error of %s, got %v", tt.wantField, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("cant generate password: %v", err)
+				}
+
+				if len(password) != tt.policy.Length {
+					t.Fatalf("length of %q = %d, want %d", password, len(password), tt.policy.Length)
+				}
+				for _, c := range password {
+					if !strings.ContainsRune(tt.alphabet, c) {
+						t.Fatalf("password %q contains %q that is not allowed", password, c)
+					}
+				}
+				for characters, min := range map[string]int{
+					lowerLetters:    tt.policy.MinLower,
+					upperLetters:    tt.policy.MinUpper,
+					digitCharacters: tt.policy.MinDigits,
+					symbols:         tt.policy.MinSymbols,
+				} {
+					count := 0
+					for _, c := range password {
+						if strings.ContainsRune(characters, c) {
+							count++
+						}
+					}
+					if count < min {
+						t.Fatalf("password %q has %d of %q, want at least %d", password, count, characters, min)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	generator := NewPasswordGenerator(&TrulyRandomGenerator{})
+
+	tests := []struct {
+		name    string
+		policy  PassphrasePolicy
+		wantErr bool
+	}{
+		// some words of the wordlist contain default divider, so words are divided by other characters
+		{name: "default words", policy: PassphrasePolicy{Words: DefaultPassphraseWords, Divider: "_"}},
+		{name: "capitalized with number", policy: PassphrasePolicy{Words: MinPassphraseWords, Divider: " ", Capitalize: true, IncludeNumber: true}},
+		{name: "too few words", policy: PassphrasePolicy{Words: MinPassphraseWords - 1, Divider: "-"}, wantErr: true},
+		{name: "too many words", policy: PassphrasePolicy{Words: MaxPassphraseWords + 1, Divider: "-"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passphrase, err := generator.GeneratePassphrase(tt.policy)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got passphrase %q", passphrase)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cant generate passphrase: %v", err)
+			}
+
+			words := strings.Split(passphrase, tt.policy.Divider)
+			if len(words) != tt.policy.Words {
+				t.Fatalf("passphrase %q has %d words, want %d", passphrase, len(words), tt.policy.Words)
+			}
+
+			hasNumber := false
+			for _, word := range words {
+				if tt.policy.Capitalize && !unicode.IsUpper([]rune(word)[0]) {
+					t.Errorf("word %q of %q is not capitalized", word, passphrase)
+				}
+				if strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+					hasNumber = true
+				}
+			}
+			if tt.policy.IncludeNumber && !hasNumber {
+				t.Errorf("passphrase %q has no number", passphrase)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package services
+
+import (
+	_ "embed"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MaxPasswordScore is the score of the strongest passwords.
+const MaxPasswordScore = 4
+
+const (
+	minPasswordLength = 8
+
+	// minimal length of patterns that are detected in passwords
+	minPatternLength = 3
+	minWordLength    = 3
+
+	// entropy in bits that is required to get score 1, 2, 3 and 4
+	weakPasswordEntropy       = 28
+	fairPasswordEntropy       = 40
+	goodPasswordEntropy       = 56
+	strongPasswordEntropy     = 72
+	patternVariationsEntropy  = 1.0
+	yearEntropy               = 7.0
+	repeatedCharacterEntropy  = 2.0
+	sequenceDirectionsEntropy = 1.0
+)
+
+// Warnings returned by EstimatePasswordStrength.
+const (
+	WarningTooShort       = "password is shorter than 8 characters"
+	WarningCommonPassword = "this is a commonly used password"
+	WarningCommonWord     = "avoid dictionary words and common passwords"
+	WarningUserInput      = "avoid using your login or name of the secret"
+	WarningKeyboard       = "avoid keyboard patterns like qwerty"
+	WarningSequence       = "avoid sequences like abc or 123"
+	WarningRepeat         = "avoid repeated characters and words"
+	WarningYear           = "avoid years and dates"
+)
+
+//go:embed dictionaries/common_passwords.txt
+var commonPasswordsList string
+
+//go:embed dictionaries/english_words.txt
+var englishWordsList string
+
+// passwordDictionaries map lowercase words to their rank. The lower the rank, the more common is the word.
+//
+//nolint:gochecknoglobals
+var (
+	commonPasswords = rankedDictionary(commonPasswordsList)
+	englishWords    = rankedDictionary(englishWordsList)
+)
+
+//nolint:gochecknoglobals
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+	"1qaz2wsx3edc4rfv5tgb6yhn7ujm8ik,9ol.0p;/",
+	"qazwsxedcrfvtgbyhnujmikolp",
+}
+
+//nolint:gochecknoglobals
+var leetSubstitutions = strings.NewReplacer(
+	"4", "a", "@", "a", "8", "b", "(", "c", "3", "e", "6", "g", "1", "i", "!", "i",
+	"|", "l", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t", "2", "z",
+)
+
+// PasswordStrength is result of password strength estimation.
+type PasswordStrength struct {
+	Warnings []string
+	Entropy  float64 // estimated entropy in bits
+	Score    int     // from 0 to MaxPasswordScore
+}
+
+// passwordPattern is a weak part of password that can be guessed with fewer attempts than random characters.
+type passwordPattern struct {
+	warning    string
+	start, end int // runes [start, end) of password
+	entropy    float64
+}
+
+// EstimatePasswordStrength estimates entropy of password taking into account common passwords,
+// dictionary words, keyboard patterns, sequences, repeats and years.
+// User inputs, like login, are treated as dictionary words.
+func EstimatePasswordStrength(password string, userInputs ...string) PasswordStrength {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return PasswordStrength{Warnings: []string{WarningTooShort}}
+	}
+
+	lower := []rune(strings.ToLower(password))
+	if _, ok := commonPasswords[string(lower)]; ok {
+		return PasswordStrength{Warnings: []string{WarningCommonPassword}}
+	}
+
+	patterns := findDictionaryPatterns(runes, lower, userInputs)
+	patterns = append(patterns, findKeyboardPatterns(lower)...)
+	patterns = append(patterns, findSequencePatterns(lower)...)
+	patterns = append(patterns, findRepeatPatterns(lower)...)
+	patterns = append(patterns, findYearPatterns(lower)...)
+
+	entropy, used := cheapestCover(len(runes), characterEntropy(runes), patterns)
+
+	strength := PasswordStrength{
+		Entropy: entropy,
+		Score:   entropyScore(entropy),
+	}
+	if len(runes) < minPasswordLength {
+		strength.Warnings = append(strength.Warnings, WarningTooShort)
+	}
+
+	seen := make(map[string]bool)
+	for _, pattern := range used {
+		if !seen[pattern.warning] {
+			seen[pattern.warning] = true
+			strength.Warnings = append(strength.Warnings, pattern.warning)
+		}
+	}
+
+	return strength
+}
+
+// cheapestCover finds the way to build password from random characters and patterns
+// that needs the least entropy. It returns this entropy and patterns that were used.
+func cheapestCover(length int, charEntropy float64, patterns []passwordPattern) (float64, []passwordPattern) {
+	byEnd := make(map[int][]passwordPattern)
+	for _, pattern := range patterns {
+		byEnd[pattern.end] = append(byEnd[pattern.end], pattern)
+	}
+
+	cost := make([]float64, length+1)
+	last := make([]*passwordPattern, length+1)
+	for i := 1; i <= length; i++ {
+		cost[i] = cost[i-1] + charEntropy
+		for j := range byEnd[i] {
+			pattern := byEnd[i][j]
+			if candidate := cost[pattern.start] + pattern.entropy; candidate < cost[i] {
+				cost[i] = candidate
+				last[i] = &byEnd[i][j]
+			}
+		}
+	}
+
+	var used []passwordPattern
+	for i := length; i > 0; {
+		if last[i] == nil {
+			i--
+			continue
+		}
+		used = append(used, *last[i])
+		i = last[i].start
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i].start < used[j].start })
+
+	return cost[length], used
+}
+
+func entropyScore(entropy float64) int {
+	switch {
+	case entropy < weakPasswordEntropy:
+		return 0
+	case entropy < fairPasswordEntropy:
+		return 1
+	case entropy < goodPasswordEntropy:
+		return 2
+	case entropy < strongPasswordEntropy:
+		return 3
+	}
+	return MaxPasswordScore
+}
+
+// characterEntropy returns entropy of one random character from character classes used in password.
+func characterEntropy(password []rune) float64 {
+	var hasLower, hasUpper, hasDigit, hasSymbol, hasOther bool
+	for _, r := range password {
+		switch {
+		case r > unicode.MaxASCII:
+			hasOther = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		present bool
+		size    int
+	}{
+		{hasLower, 26}, {hasUpper, 26}, {hasDigit, 10}, {hasSymbol, 33}, {hasOther, 100},
+	} {
+		if class.present {
+			pool += class.size
+		}
+	}
+
+	return math.Log2(float64(pool))
+}
+
+func findDictionaryPatterns(original []rune, lower []rune, userInputs []string) []passwordPattern {
+	inputs := make(map[string]int, len(userInputs))
+	for i, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if len([]rune(input)) >= minWordLength {
+			inputs[input] = i + 1
+		}
+	}
+
+	var patterns []passwordPattern
+	for start := 0; start < len(lower); start++ {
+		for end := start + minWordLength; end <= len(lower); end++ {
+			word := string(lower[start:end])
+			unleeted := leetSubstitutions.Replace(word)
+
+			variations := 0.0
+			if hasUpper(original[start:end]) {
+				variations += patternVariationsEntropy
+			}
+			if unleeted != word {
+				variations += patternVariationsEntropy
+			}
+
+			for _, dictionary := range []struct {
+				words   map[string]int
+				warning string
+			}{
+				{inputs, WarningUserInput},
+				{commonPasswords, WarningCommonWord},
+				{englishWords, WarningCommonWord},
+			} {
+				rank, ok := dictionary.words[word]
+				if !ok {
+					rank, ok = dictionary.words[unleeted]
+				}
+				if !ok {
+					continue
+				}
+				patterns = append(patterns, passwordPattern{
+					start:   start,
+					end:     end,
+					entropy: math.Log2(float64(rank+1)) + variations,
+					warning: dictionary.warning,
+				})
+			}
+		}
+	}
+
+	return patterns
+}
+
+// findKeyboardPatterns finds runs of adjacent keys of the same keyboard row or column in both directions.
+func findKeyboardPatterns(lower []rune) []passwordPattern {
+	var patterns []passwordPattern
+	for _, row := range keyboardRows {
+		row := row
+		for _, step := range []int{1, -1} {
+			step := step
+			patterns = append(patterns, findRuns(lower, func(previous, current rune) bool {
+				i := strings.IndexRune(row, previous)
+				return i >= 0 && i+step >= 0 && i+step < len(row) && rune(row[i+step]) == current
+			}, math.Log2(float64(len(keyboardRows)*len(row)))+sequenceDirectionsEntropy, WarningKeyboard)...)
+		}
+	}
+
+	return patterns
+}
+
+// findSequencePatterns finds alphabetical and numerical sequences in both directions.
+func findSequencePatterns(lower []rune) []passwordPattern {
+	var patterns []passwordPattern
+	for _, step := range []rune{1, -1} {
+		step := step
+		patterns = append(patterns, findRuns(lower, func(previous, current rune) bool {
+			return current-previous == step &&
+				(unicode.IsDigit(previous) && unicode.IsDigit(current) ||
+					unicode.IsLetter(previous) && unicode.IsLetter(current))
+		}, math.Log2(26)+sequenceDirectionsEntropy, WarningSequence)...)
+	}
+
+	return patterns
+}
+
+// findRuns finds runs of at least minPatternLength characters where each pair of
+// neighbours is adjacent. Entropy of the run is entropy of its start plus entropy of its length.
+func findRuns(lower []rune, adjacent func(previous, current rune) bool, startEntropy float64, warning string) []passwordPattern {
+	var patterns []passwordPattern
+	start := 0
+	for i := 1; i <= len(lower); i++ {
+		if i < len(lower) && adjacent(lower[i-1], lower[i]) {
+			continue
+		}
+		if i-start >= minPatternLength {
+			patterns = append(patterns, passwordPattern{
+				start:   start,
+				end:     i,
+				entropy: startEntropy + math.Log2(float64(i-start)),
+				warning: warning,
+			})
+		}
+		start = i
+	}
+
+	return patterns
+}
+
+// findRepeatPatterns finds characters and substrings that are repeated several times in a row.
+func findRepeatPatterns(lower []rune) []passwordPattern {
+	var patterns []passwordPattern
+	for start := 0; start < len(lower); start++ {
+		for unit := 1; start+2*unit <= len(lower); unit++ {
+			end := start + unit
+			for end+unit <= len(lower) && string(lower[end:end+unit]) == string(lower[start:start+unit]) {
+				end += unit
+			}
+			repeats := (end - start) / unit
+			if repeats < 2 || (unit == 1 && repeats < minPatternLength) {
+				continue
+			}
+
+			unitEntropy := characterEntropy(lower[start:start+unit]) * float64(unit)
+			if unit == 1 {
+				unitEntropy = characterEntropy(lower[start:end]) + repeatedCharacterEntropy
+			}
+			patterns = append(patterns, passwordPattern{
+				start:   start,
+				end:     end,
+				entropy: unitEntropy + math.Log2(float64(repeats)),
+				warning: WarningRepeat,
+			})
+		}
+	}
+
+	return patterns
+}
+
+// findYearPatterns finds years from 1900 to 2099.
+func findYearPatterns(lower []rune) []passwordPattern {
+	var patterns []passwordPattern
+	for start := 0; start+4 <= len(lower); start++ {
+		year := string(lower[start : start+4])
+		if (strings.HasPrefix(year, "19") || strings.HasPrefix(year, "20")) && isDigits(year) {
+			patterns = append(patterns, passwordPattern{
+				start:   start,
+				end:     start + 4,
+				entropy: yearEntropy,
+				warning: WarningYear,
+			})
+		}
+	}
+
+	return patterns
+}
+
+func hasUpper(runes []rune) bool {
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func rankedDictionary(list string) map[string]int {
+	words := strings.Fields(list)
+	ranked := make(map[string]int, len(words))
+	for rank, word := range words {
+		if _, ok := ranked[word]; !ok {
+			ranked[word] = rank + 1
+		}
+	}
+	return ranked
+}
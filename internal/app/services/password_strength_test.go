@@ -0,0 +1,40 @@
+package services
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimatePasswordStrength(t *testing.T) {
+	tests := []struct {
+		password    string
+		userInputs  []string
+		wantScore   int
+		wantWarning string
+	}{
+		{password: "", wantScore: 0, wantWarning: WarningTooShort},
+		{password: "password", wantScore: 0, wantWarning: WarningCommonPassword},
+		{password: "abcdefgh123", wantScore: 0, wantWarning: WarningSequence},
+		{password: "aaaaaaaaaaaa", wantScore: 0, wantWarning: WarningRepeat},
+		{password: "john1990", wantScore: 0, wantWarning: WarningYear},
+		{password: "johnsmith2024!", userInputs: []string{"johnsmith", "github"}, wantScore: 0, wantWarning: WarningUserInput},
+		{password: "correct-horse-battery-staple", wantScore: 2, wantWarning: WarningCommonWord},
+		{password: "Xk9#mQ2$vL7!pR4&", wantScore: MaxPasswordScore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			strength := EstimatePasswordStrength(tt.password, tt.userInputs...)
+			if strength.Score != tt.wantScore {
+				t.Errorf("score = %d, want %d", strength.Score, tt.wantScore)
+			}
+
+			warnings := strings.Join(strength.Warnings, "; ")
+			if tt.wantWarning == "" && len(strength.Warnings) != 0 {
+				t.Errorf("expected no warnings, got %q", warnings)
+			}
+			if tt.wantWarning != "" && !strings.Contains(warnings, tt.wantWarning) {
+				t.Errorf("expected warning %q, got %q", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
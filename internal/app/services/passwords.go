@@ -0,0 +1,136 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type PasswordsManagerInterface interface {
+	EstimateStrength(password string, userInputs ...string) PasswordStrength
+	GetMinScore(userID int) (int, error)
+	SetMinScore(userID int, score int) error
+	GeneratePassword(policy GenerationPolicy) (string, error)
+	GeneratePassphrase(policy PassphrasePolicy) (string, error)
+	CheckBreached(password string) (int, error)
+	CheckBreachedRange(prefix string) ([]BreachedHash, error)
+}
+
+// PasswordsManager generates passwords, estimates their strength and enforces per user minimal strength.
+type PasswordsManager struct {
+	usersRepo storage.UserStorage
+	generator *PasswordGenerator
+	breaches  *BreachIndex
+}
+
+func NewPasswordsService(usersStorage storage.UserStorage, random Generator) *PasswordsManager {
+	return &PasswordsManager{
+		usersRepo: usersStorage,
+		generator: NewPasswordGenerator(random),
+	}
+}
+
+// UseBreachIndex enables checking of passwords against index of breached passwords.
+func (p *PasswordsManager) UseBreachIndex(index *BreachIndex) {
+	p.breaches = index
+}
+
+// CheckBreached returns how many times password was seen in breaches.
+// ErrBreachIndexNotLoaded is returned if index of breached passwords is not used.
+func (p *PasswordsManager) CheckBreached(password string) (int, error) {
+	if p.breaches == nil {
+		return 0, ErrBreachIndexNotLoaded
+	}
+
+	count, err := p.breaches.Count(password)
+	if err != nil {
+		log.Error().Err(err).Msg("cant check breached password")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CheckBreachedRange returns breached hashes that start with prefix of sha-1 hash of password.
+// ErrBreachIndexNotLoaded is returned if index of breached passwords is not used.
+func (p *PasswordsManager) CheckBreachedRange(prefix string) ([]BreachedHash, error) {
+	if p.breaches == nil {
+		return nil, ErrBreachIndexNotLoaded
+	}
+
+	hashes, err := p.breaches.Range(prefix)
+	if err != nil && !errors.Is(err, ErrInvalidHashPrefix) {
+		log.Error().Err(err).Msg("cant check range of breached hashes")
+	}
+
+	return hashes, err
+}
+
+func (p *PasswordsManager) GeneratePassword(policy GenerationPolicy) (string, error) {
+	return p.generator.GeneratePassword(policy)
+}
+
+func (p *PasswordsManager) GeneratePassphrase(policy PassphrasePolicy) (string, error) {
+	return p.generator.GeneratePassphrase(policy)
+}
+
+func (p *PasswordsManager) EstimateStrength(password string, userInputs ...string) PasswordStrength {
+	return EstimatePasswordStrength(password, userInputs...)
+}
+
+// GetMinScore returns the lowest strength score of passwords that user is allowed to save.
+// Score 0 means that any password is allowed.
+func (p *PasswordsManager) GetMinScore(userID int) (int, error) {
+	score, err := p.usersRepo.GetMinPasswordScore(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get min password score")
+		return 0, err
+	}
+
+	return score, nil
+}
+
+func (p *PasswordsManager) SetMinScore(userID int, score int) error {
+	if score < 0 || score > MaxPasswordScore {
+		return NewValidationError("min_score", fmt.Sprintf("score must be between 0 and %d", MaxPasswordScore))
+	}
+
+	err := p.usersRepo.SetMinPasswordScore(userID, score)
+	if err != nil {
+		log.Error().Err(err).Msg("cant set min password score")
+		return err
+	}
+
+	return nil
+}
+
+// Validate rejects passwords that are weaker than minimal score of the user.
+func (p *PasswordsManager) Validate(secret models.Secret, metadata models.SecretMetadata) error {
+	password, ok := secret.(*models.PasswordSecret)
+	if !ok {
+		return nil
+	}
+
+	minScore, err := p.GetMinScore(metadata.UserID)
+	if err != nil {
+		return err
+	}
+	if minScore == 0 {
+		return nil
+	}
+
+	strength := p.EstimateStrength(password.Password, password.Login, metadata.Name)
+	if strength.Score >= minScore {
+		return nil
+	}
+
+	message := fmt.Sprintf("password is too weak: score %d, required %d", strength.Score, minScore)
+	if len(strength.Warnings) > 0 {
+		message += ": " + strings.Join(strength.Warnings, ", ")
+	}
+	return NewValidationError("password", message)
+}
@@ -3,72 +3,219 @@ package services
 import (
 	"bytes"
 	"encoding/gob"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
 	"github.com/belamov/ypgo-password-manager/internal/app/models"
 	"github.com/belamov/ypgo-password-manager/internal/app/storage"
 	"github.com/rs/zerolog/log"
 )
 
 type SecretsManagerInterface interface {
+	PutSecret(secret models.Secret, metadata models.SecretMetadata) (int64, error)
+	GetDecodedSecret(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error)
+	RevealSecret(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error)
 	SaveSecret(encodedSecret []byte, metadata models.SecretMetadata) error
-	GetPassword(metadata models.SecretMetadata) (*models.PasswordSecret, error)
-	GetCard(metadata models.SecretMetadata) (*models.CardSecret, error)
-	GetText(metadata models.SecretMetadata) (*models.TextSecret, error)
+	UpdateSecret(encodedSecret []byte, metadata models.SecretMetadata) (int64, error)
+	ListSecrets(filter models.SecretsFilter) ([]models.SecretMetadata, int64, error)
+	DeleteSecret(metadata models.SecretMetadata) error
+	ListTrash(filter models.SecretsFilter) ([]models.SecretMetadata, int64, error)
+	RestoreSecret(metadata models.SecretMetadata) error
+	PurgeSecret(metadata models.SecretMetadata) error
+	ListRevisions(metadata models.SecretMetadata) ([]models.SecretRevision, error)
+	GetRevision(metadata models.SecretMetadata, version int64) (models.Secret, models.SecretRevision, error)
+	RestoreRevision(metadata models.SecretMetadata, version int64) (int64, error)
+	SaveFile(metadata models.SecretMetadata, fileName string, r io.Reader) (*models.BinarySecret, error)
+	SaveEncryptedFile(metadata models.SecretMetadata, readChunk func() ([]byte, error), encryptedInfo func() []byte) error
+	GetFileInfo(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error)
+	ReadFile(metadata models.SecretMetadata, w io.Writer) error
+	GetTOTPCode(metadata models.SecretMetadata) (string, time.Duration, error)
+	SecurityReport(userID int, collectionID int64, options SecurityReportOptions) (SecurityReport, error)
+	ListExpiring(userID int, collectionID int64, window time.Duration) ([]models.DueSecret, error)
+	FindByURL(userID int, collectionID int64, rawURL string) ([]PasswordMatch, error)
+	ShareSecret(metadata models.SecretMetadata, username string, permission models.SharePermission) error
+	RevokeShare(metadata models.SecretMetadata, username string) error
+	ListShares(metadata models.SecretMetadata) ([]models.SecretShare, error)
+	ListSharedWithMe(userID int) ([]models.SharedSecret, error)
+	CreateShareLink(metadata models.SecretMetadata, maxRedemptions int, ttl time.Duration) (string, models.ShareLink, error)
+	RedeemShareLink(token string) (models.Secret, models.ShareLink, error)
+	RotateVaultKey(userID int, collectionID int64) error
+	DeleteVaultKey(userID int, collectionID int64) error
 }
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+
+	// initialSecretVersion is the version of just created secret
+	initialSecretVersion = 1
+)
+
+var (
+	// ErrUnknownSecretType is returned when secret type is not registered in models.
+	ErrUnknownSecretType = errors.New("unknown secret type")
+	// ErrClientEncrypted is returned when the action needs data of secret that is encrypted by the client.
+	ErrClientEncrypted = errors.New("secret is encrypted by the client")
+)
+
 type SecretsManager struct {
-	secretsRepo   storage.SecretsStorage
-	cryptographer Cryptographer
+	secretsRepo storage.SecretsStorage
+	keys        KeyRing
+	random      Generator
+	validators  map[models.SecretType][]SecretValidator
 }
 
-func (s *SecretsManager) GetPassword(metadata models.SecretMetadata) (*models.PasswordSecret, error) {
-	secret := &models.PasswordSecret{}
-	encodedSecret, err := s.GetSecret(metadata)
-	dec := gob.NewDecoder(bytes.NewReader(encodedSecret))
-	err = dec.Decode(&secret)
+// PutSecret creates secret if metadata.Version is 0, otherwise it updates existing secret
+// with optimistic concurrency check. Type of the secret is taken from the secret itself.
+// Secret shared with the user is updated if it is shared with read-write permission.
+// models.EncryptedSecret is saved as it is, without validation and labels, because server can't read it.
+// It returns the new version of the secret.
+func (s *SecretsManager) PutSecret(secret models.Secret, metadata models.SecretMetadata) (int64, error) {
+	metadata.Type = secret.Type()
+	_, metadata.ClientEncrypted = secret.(*models.EncryptedSecret)
+
+	if metadata.Version == 0 {
+		if metadata.Shared {
+			return 0, NewValidationError("version", "shared secret can only be updated")
+		}
+		if err := validateSecretName(metadata.Name); err != nil {
+			return 0, err
+		}
+	}
+
+	var err error
+	if metadata.ClientEncrypted {
+		err = validateEncryptedSecret(secret)
+	} else {
+		err = s.validate(secret, metadata)
+	}
 	if err != nil {
-		log.Error().Err(err).Msg("cant decode secret")
-		return nil, err
+		return 0, err
+	}
+
+	if labeled, ok := secret.(models.LabeledSecret); ok {
+		metadata.Labels, err = s.mergeSecretLabels(metadata, labeled.SecretLabels())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	encodedSecret, err := secret.ToBinary()
+	if err != nil {
+		log.Error().Err(err).Msg("cant encode secret")
+		return 0, err
+	}
+
+	if metadata.Version == 0 {
+		err = s.SaveSecret(encodedSecret, metadata)
+		if err != nil {
+			return 0, err
+		}
+		return initialSecretVersion, nil
 	}
-	return secret, err
+
+	return s.UpdateSecret(encodedSecret, metadata)
 }
 
-func (s *SecretsManager) GetCard(metadata models.SecretMetadata) (*models.CardSecret, error) {
-	secret := &models.CardSecret{}
-	encodedSecret, err := s.GetSecret(metadata)
-	dec := gob.NewDecoder(bytes.NewReader(encodedSecret))
-	err = dec.Decode(&secret)
+// GetDecodedSecret returns decrypted secret of any registered type.
+// Secrets that implement models.MaskedSecret are returned masked, use RevealSecret to get them in full.
+func (s *SecretsManager) GetDecodedSecret(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error) {
+	secret, metadata, err := s.decodeSecret(metadata)
 	if err != nil {
-		log.Error().Err(err).Msg("cant decode secret")
-		return nil, err
+		return nil, metadata, err
+	}
+
+	return maskSecret(secret), metadata, nil
+}
+
+// RevealSecret returns decrypted secret without masking.
+func (s *SecretsManager) RevealSecret(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error) {
+	return s.decodeSecret(metadata)
+}
+
+// decodeSecret returns decrypted secret of any registered type as it is stored.
+// Secret encrypted by the client is returned as models.EncryptedSecret.
+func (s *SecretsManager) decodeSecret(metadata models.SecretMetadata) (models.Secret, models.SecretMetadata, error) {
+	encodedSecret, metadata, err := s.GetSecret(metadata)
+	if err != nil {
+		return nil, metadata, err
 	}
-	return secret, err
+
+	secret, err := decodeSecretData(encodedSecret, metadata.Type, metadata.ClientEncrypted)
+	return secret, metadata, err
 }
 
-func (s *SecretsManager) GetText(metadata models.SecretMetadata) (*models.TextSecret, error) {
-	secret := &models.TextSecret{}
-	encodedSecret, err := s.GetSecret(metadata)
+// decodeSecretData decodes decrypted data of secret of type secretType.
+func decodeSecretData(encodedSecret []byte, secretType models.SecretType, clientEncrypted bool) (models.Secret, error) {
+	if clientEncrypted {
+		return &models.EncryptedSecret{Data: encodedSecret, SecretType: secretType}, nil
+	}
+
+	secret := models.NewSecret(secretType)
+	if secret == nil {
+		return nil, ErrUnknownSecretType
+	}
+
 	dec := gob.NewDecoder(bytes.NewReader(encodedSecret))
-	err = dec.Decode(&secret)
+	err := dec.Decode(secret)
 	if err != nil {
 		log.Error().Err(err).Msg("cant decode secret")
 		return nil, err
 	}
-	return secret, err
+
+	return secret, nil
 }
 
-func NewSecretsService(secretsStorage storage.SecretsStorage, cryptographer Cryptographer) *SecretsManager {
-	return &SecretsManager{
-		secretsRepo:   secretsStorage,
-		cryptographer: cryptographer,
+// mergeSecretLabels adds labels derived from secret data to labels of the secret.
+// Current labels of existing secret are kept if metadata.Labels is nil.
+func (s *SecretsManager) mergeSecretLabels(metadata models.SecretMetadata, secretLabels map[string]string) (map[string]string, error) {
+	labels := metadata.Labels
+	if labels == nil && metadata.Version != 0 {
+		_, current, err := s.secretsRepo.FindSecretData(metadata)
+		if err != nil {
+			log.Error().Err(err).Msg("cant get secret labels")
+			return nil, err
+		}
+		labels = current.Labels
+	}
+
+	merged := make(map[string]string, len(labels)+len(secretLabels))
+	for key, value := range labels {
+		merged[key] = value
+	}
+	for key, value := range secretLabels {
+		merged[key] = value
 	}
+
+	return merged, nil
+}
+
+func NewSecretsService(secretsStorage storage.SecretsStorage, keys KeyRing, random Generator) *SecretsManager {
+	manager := &SecretsManager{
+		secretsRepo: secretsStorage,
+		keys:        keys,
+		random:      random,
+	}
+	manager.RegisterValidator(models.SecretTypeTOTP, TOTPValidator{})
+	manager.RegisterValidator(models.SecretTypeSSHKey, SSHKeyValidator{})
+	manager.RegisterValidator(models.SecretTypeCard, NewCardValidator(manager.RevealSecret))
+	manager.RegisterValidator(models.SecretTypePassword, PasswordURLsValidator{})
+
+	return manager
 }
 
+// SaveSecret encrypts new secret with data key of its vault and saves it.
+// Secret that is encrypted by the client is saved as it is.
 func (s *SecretsManager) SaveSecret(encodedSecret []byte, metadata models.SecretMetadata) error {
-	encryptedData, err := s.cryptographer.Encrypt(encodedSecret)
+	metadata.Tags = normalizeTags(metadata.Tags)
+
+	encryptedData, dataKeyID, err := s.encrypt(encodedSecret, metadata)
 	if err != nil {
-		log.Error().Err(err).Msg("cant encrypt secret")
 		return err
 	}
+	metadata.DataKeyID = dataKeyID
 
 	err = s.secretsRepo.CreateNew(encryptedData, metadata)
 	if err != nil {
@@ -79,14 +226,89 @@ func (s *SecretsManager) SaveSecret(encodedSecret []byte, metadata models.Secret
 	return nil
 }
 
-func (s *SecretsManager) GetSecret(metadata models.SecretMetadata) ([]byte, error) {
-	encryptedData, err := s.secretsRepo.FindSecretData(metadata)
+// UpdateSecret replaces data of existing secret. metadata.Version must be equal to
+// the current version of the secret, otherwise storage.SecretVersionConflictError is returned.
+// It returns the new version of the secret.
+func (s *SecretsManager) UpdateSecret(encodedSecret []byte, metadata models.SecretMetadata) (int64, error) {
+	metadata.Tags = normalizeTags(metadata.Tags)
+
+	vault := metadata
+	if metadata.Shared && !metadata.ClientEncrypted {
+		// shared secret stays in the vault of its owner
+		_, current, err := s.secretsRepo.FindSecretData(metadata)
+		if err != nil {
+			log.Error().Err(err).Msg("cant get owner of shared secret")
+			return 0, err
+		}
+		vault.UserID = current.UserID
+		vault.CollectionID = current.CollectionID
+	}
+
+	encryptedData, dataKeyID, err := s.encrypt(encodedSecret, vault)
+	if err != nil {
+		return 0, err
+	}
+	metadata.DataKeyID = dataKeyID
+
+	version, err := s.secretsRepo.Update(encryptedData, metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant update secret")
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// GetSecret returns decrypted data of secret. Data of secret that is encrypted by the client
+// is returned as it is stored, metadata.ClientEncrypted tells which one is returned.
+func (s *SecretsManager) GetSecret(metadata models.SecretMetadata) ([]byte, models.SecretMetadata, error) {
+	encryptedData, metadata, err := s.secretsRepo.FindSecretData(metadata)
 	if err != nil {
 		log.Error().Err(err).Msg("cant get secret from storage")
+		return nil, metadata, err
+	}
+
+	decryptedData, err := s.decrypt(encryptedData, metadata.ClientEncrypted, metadata.DataKeyID)
+	if err != nil {
+		return nil, metadata, err
+	}
+
+	return decryptedData, metadata, nil
+}
+
+// encrypt encrypts data with data key of the vault of the secret unless it is already encrypted by the client.
+// It returns id of the data key.
+func (s *SecretsManager) encrypt(data []byte, metadata models.SecretMetadata) ([]byte, int64, error) {
+	if metadata.ClientEncrypted {
+		return data, 0, nil
+	}
+
+	dataKeyID, cryptographer, err := s.keys.VaultCryptographer(metadata.UserID, metadata.CollectionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	encryptedData, err := cryptographer.Encrypt(data)
+	if err != nil {
+		log.Error().Err(err).Msg("cant encrypt secret")
+		return nil, 0, err
+	}
+
+	return encryptedData, dataKeyID, nil
+}
+
+// decrypt decrypts data with data key that encrypted it unless it is encrypted by the client.
+func (s *SecretsManager) decrypt(data []byte, clientEncrypted bool, dataKeyID int64) ([]byte, error) {
+	if clientEncrypted {
+		return data, nil
+	}
+
+	cryptographer, err := s.keys.DataKeyCryptographer(dataKeyID)
+	if err != nil {
 		return nil, err
 	}
 
-	decryptedData, err := s.cryptographer.Decrypt(encryptedData)
+	decryptedData, err := cryptographer.Decrypt(data)
 	if err != nil {
 		log.Error().Err(err).Msg("cant decrypt data")
 		return nil, err
@@ -94,3 +316,166 @@ func (s *SecretsManager) GetSecret(metadata models.SecretMetadata) ([]byte, erro
 
 	return decryptedData, nil
 }
+
+// ListSecrets returns one page of secrets metadata matching the filter
+// and the cursor of the next page. Next cursor is 0 when there are no more pages.
+func (s *SecretsManager) ListSecrets(filter models.SecretsFilter) ([]models.SecretMetadata, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+	filter.Tags = normalizeTags(filter.Tags)
+
+	secrets, err := s.secretsRepo.ListSecrets(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list secrets")
+		return nil, 0, err
+	}
+
+	if len(secrets) <= limit {
+		return secrets, 0, nil
+	}
+
+	secrets = secrets[:limit]
+	return secrets, secrets[limit-1].ID, nil
+}
+
+// DeleteSecret moves secret to the trash. It can be restored until it is purged.
+func (s *SecretsManager) DeleteSecret(metadata models.SecretMetadata) error {
+	err := s.secretsRepo.Delete(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant delete secret")
+		return err
+	}
+
+	return nil
+}
+
+// ListTrash returns one page of deleted secrets metadata and the cursor of the next page.
+func (s *SecretsManager) ListTrash(filter models.SecretsFilter) ([]models.SecretMetadata, int64, error) {
+	filter.Deleted = true
+	return s.ListSecrets(filter)
+}
+
+// RestoreSecret moves secret with metadata.ID from the trash back to active secrets.
+func (s *SecretsManager) RestoreSecret(metadata models.SecretMetadata) error {
+	err := s.secretsRepo.Restore(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant restore secret")
+		return err
+	}
+
+	return nil
+}
+
+// PurgeSecret permanently removes secret with metadata.ID from the trash.
+func (s *SecretsManager) PurgeSecret(metadata models.SecretMetadata) error {
+	err := s.secretsRepo.Purge(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant purge secret")
+		return err
+	}
+
+	return nil
+}
+
+// PurgeExpiredTrash permanently removes secrets that stay in the trash longer than retention.
+func (s *SecretsManager) PurgeExpiredTrash(retention time.Duration) error {
+	purged, err := s.secretsRepo.PurgeDeletedBefore(time.Now().Add(-retention))
+	if err != nil {
+		log.Error().Err(err).Msg("cant purge expired trash")
+		return err
+	}
+
+	log.Info().Msgf("purged %d secrets from trash", purged)
+	return nil
+}
+
+// ListExpiring returns secrets of personal vault of the user or of collection if collectionID is not zero
+// that are overdue or will be due within window.
+func (s *SecretsManager) ListExpiring(userID int, collectionID int64, window time.Duration) ([]models.DueSecret, error) {
+	secrets, err := s.secretsRepo.ListDue(userID, collectionID, time.Now().Add(window))
+	if err != nil {
+		log.Error().Err(err).Msg("cant list expiring secrets")
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// ListRevisions returns previous versions of secret, newest first.
+func (s *SecretsManager) ListRevisions(metadata models.SecretMetadata) ([]models.SecretRevision, error) {
+	revisions, err := s.secretsRepo.ListRevisions(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list revisions")
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// GetRevision returns decrypted data of the secret as it was at given version.
+// Like GetDecodedSecret it masks secrets that support masking.
+func (s *SecretsManager) GetRevision(metadata models.SecretMetadata, version int64) (models.Secret, models.SecretRevision, error) {
+	encryptedData, revision, err := s.secretsRepo.FindRevisionData(metadata, version)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get revision from storage")
+		return nil, revision, err
+	}
+
+	decryptedData, err := s.decrypt(encryptedData, revision.ClientEncrypted, revision.DataKeyID)
+	if err != nil {
+		return nil, revision, err
+	}
+
+	secret, err := decodeSecretData(decryptedData, metadata.Type, revision.ClientEncrypted)
+	if err != nil {
+		return nil, revision, err
+	}
+
+	return maskSecret(secret), revision, nil
+}
+
+// RestoreRevision makes data of given version current. It returns the new version of the secret.
+func (s *SecretsManager) RestoreRevision(metadata models.SecretMetadata, version int64) (int64, error) {
+	newVersion, err := s.secretsRepo.RestoreRevision(metadata, version)
+	if err != nil {
+		log.Error().Err(err).Msg("cant restore revision")
+		return 0, err
+	}
+
+	return newVersion, nil
+}
+
+// maskSecret hides sensitive parts of secrets that support masking.
+func maskSecret(secret models.Secret) models.Secret {
+	if masked, ok := secret.(models.MaskedSecret); ok {
+		return masked.Masked()
+	}
+	return secret
+}
+
+// normalizeTags trims tags and removes empty and duplicate ones. Nil tags stay nil.
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+const (
+	DefaultMaxPasswordAge    = 180 * 24 * time.Hour
+	DefaultCardExpiryWarning = 30 * 24 * time.Hour
+	// DefaultWeakPasswordScore is the lowest score of password that is not reported as weak
+	DefaultWeakPasswordScore = 2
+)
+
+// SecurityReportOptions configures what is reported. Zero values are replaced with defaults.
+type SecurityReportOptions struct {
+	MaxPasswordAge    time.Duration
+	CardExpiryWarning time.Duration
+	WeakPasswordScore int
+}
+
+// SecurityReport describes problems found in secrets of the user.
+type SecurityReport struct {
+	// ReusedPasswords contains groups of names of password secrets that share the same password
+	ReusedPasswords [][]string
+	WeakPasswords   []WeakPassword
+	OldPasswords    []OldPassword
+	ExpiredCards    []ExpiringCard
+	ExpiringCards   []ExpiringCard
+}
+
+type WeakPassword struct {
+	Name     string
+	Strength PasswordStrength
+}
+
+type OldPassword struct {
+	ChangedAt time.Time
+	Name      string
+}
+
+type ExpiringCard struct {
+	// ExpiresAt is the end of expiry month of the card
+	ExpiresAt time.Time
+	Name      string
+	Last4     string
+}
+
+// SecurityReport scans all active passwords and cards of personal vault of the user
+// or of collection if collectionID is not zero.
+// Age of password is counted from the oldest revision that has the same password,
+// so editing login or tags does not reset it.
+func (s *SecretsManager) SecurityReport(userID int, collectionID int64, options SecurityReportOptions) (SecurityReport, error) {
+	if options.MaxPasswordAge <= 0 {
+		options.MaxPasswordAge = DefaultMaxPasswordAge
+	}
+	if options.CardExpiryWarning <= 0 {
+		options.CardExpiryWarning = DefaultCardExpiryWarning
+	}
+	if options.WeakPasswordScore <= 0 {
+		options.WeakPasswordScore = DefaultWeakPasswordScore
+	}
+
+	var report SecurityReport
+	now := time.Now()
+
+	passwords, err := s.listAllSecrets(userID, collectionID, models.SecretTypePassword)
+	if err != nil {
+		return report, err
+	}
+
+	namesByPassword := make(map[string][]string)
+	for _, metadata := range passwords {
+		secret, metadata, err := s.decodeSecret(metadata)
+		if err != nil {
+			return report, err
+		}
+		password, ok := secret.(*models.PasswordSecret)
+		if !ok || password.Password == "" {
+			continue
+		}
+
+		namesByPassword[password.Password] = append(namesByPassword[password.Password], metadata.Name)
+
+		strength := EstimatePasswordStrength(password.Password, password.Login, metadata.Name)
+		if strength.Score < options.WeakPasswordScore {
+			report.WeakPasswords = append(report.WeakPasswords, WeakPassword{Name: metadata.Name, Strength: strength})
+		}
+
+		changedAt, err := s.passwordChangedAt(metadata, password.Password)
+		if err != nil {
+			return report, err
+		}
+		if now.Sub(changedAt) > options.MaxPasswordAge {
+			report.OldPasswords = append(report.OldPasswords, OldPassword{Name: metadata.Name, ChangedAt: changedAt})
+		}
+	}
+
+	for _, names := range namesByPassword {
+		if len(names) > 1 {
+			sort.Strings(names)
+			report.ReusedPasswords = append(report.ReusedPasswords, names)
+		}
+	}
+	sort.Slice(report.ReusedPasswords, func(i, j int) bool {
+		return report.ReusedPasswords[i][0] < report.ReusedPasswords[j][0]
+	})
+
+	cards, err := s.listAllSecrets(userID, collectionID, models.SecretTypeCard)
+	if err != nil {
+		return report, err
+	}
+
+	for _, metadata := range cards {
+		secret, metadata, err := s.decodeSecret(metadata)
+		if err != nil {
+			return report, err
+		}
+		card, ok := secret.(*models.CardSecret)
+		if !ok {
+			continue
+		}
+
+		expiry, err := parseCardExpiry(card.Date)
+		if err != nil {
+			continue
+		}
+
+		expiringCard := ExpiringCard{Name: metadata.Name, Last4: card.Last4(), ExpiresAt: expiry.AddDate(0, 1, 0)}
+		switch {
+		case !now.Before(expiringCard.ExpiresAt):
+			report.ExpiredCards = append(report.ExpiredCards, expiringCard)
+		case expiringCard.ExpiresAt.Sub(now) <= options.CardExpiryWarning:
+			report.ExpiringCards = append(report.ExpiringCards, expiringCard)
+		}
+	}
+
+	return report, nil
+}
+
+// listAllSecrets lists active secrets of given type walking through all pages.
+func (s *SecretsManager) listAllSecrets(userID int, collectionID int64, secretType models.SecretType) ([]models.SecretMetadata, error) {
+	filter := models.SecretsFilter{UserID: userID, CollectionID: collectionID, Type: secretType, Limit: maxListLimit}
+
+	var secrets []models.SecretMetadata
+	for {
+		page, cursor, err := s.ListSecrets(filter)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, page...)
+
+		if cursor == 0 {
+			return secrets, nil
+		}
+		filter.Cursor = cursor
+	}
+}
+
+// passwordChangedAt returns when password of the secret was set. Revisions are listed from the newest one.
+func (s *SecretsManager) passwordChangedAt(metadata models.SecretMetadata, password string) (time.Time, error) {
+	changedAt := metadata.UpdatedAt
+
+	revisions, err := s.ListRevisions(metadata)
+	if err != nil {
+		return changedAt, err
+	}
+
+	for _, revision := range revisions {
+		secret, revision, err := s.GetRevision(metadata, revision.Version)
+		if err != nil {
+			return changedAt, err
+		}
+
+		previous, ok := secret.(*models.PasswordSecret)
+		if !ok || previous.Password != password {
+			break
+		}
+		changedAt = revision.CreatedAt
+	}
+
+	return changedAt, nil
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	shareLinkTokenSize = 32
+
+	defaultShareLinkRedemptions = 1
+	maxShareLinkRedemptions     = 100
+	defaultShareLinkTTL         = 24 * time.Hour
+	maxShareLinkTTL             = 30 * 24 * time.Hour
+
+	// shareLinkKeyPrefix separates key of the snapshot from the hash that is used to find the link
+	shareLinkKeyPrefix = "share-link-key:"
+)
+
+// CreateShareLink saves snapshot of the secret encrypted with the key derived from returned token.
+// Server keeps only hash of the token, so the snapshot can be decrypted only with the token.
+// Zero maxRedemptions and ttl mean one redemption within a day.
+// Shared secret can be published only with read-write permission. Roles in collections
+// are checked by proto.AuthInterceptor, emergency access doesn't allow to create links.
+func (s *SecretsManager) CreateShareLink(metadata models.SecretMetadata, maxRedemptions int, ttl time.Duration) (string, models.ShareLink, error) {
+	if metadata.Type == models.SecretTypeBinary {
+		return "", models.ShareLink{}, NewValidationError("type", "files can't be shared by link")
+	}
+	if maxRedemptions == 0 {
+		maxRedemptions = defaultShareLinkRedemptions
+	}
+	if maxRedemptions < 0 || maxRedemptions > maxShareLinkRedemptions {
+		return "", models.ShareLink{}, NewValidationError("max_redemptions", "link can be redeemed from 1 to 100 times")
+	}
+	if ttl == 0 {
+		ttl = defaultShareLinkTTL
+	}
+	if ttl < 0 || ttl > maxShareLinkTTL {
+		return "", models.ShareLink{}, NewValidationError("ttl", "link can live up to 30 days")
+	}
+
+	if metadata.Shared {
+		permission, err := s.secretsRepo.FindSharePermission(metadata)
+		if err != nil {
+			return "", models.ShareLink{}, err
+		}
+		if permission < models.SharePermissionWrite {
+			return "", models.ShareLink{}, ErrPermissionDenied
+		}
+	}
+
+	userID := metadata.UserID
+	secret, metadata, err := s.RevealSecret(metadata)
+	if err != nil {
+		return "", models.ShareLink{}, err
+	}
+	if metadata.ClientEncrypted {
+		return "", models.ShareLink{}, ErrClientEncrypted
+	}
+
+	encodedSecret, err := secret.ToBinary()
+	if err != nil {
+		log.Error().Err(err).Msg("cant encode secret")
+		return "", models.ShareLink{}, err
+	}
+
+	token, err := s.random.GenerateRandomBytes(shareLinkTokenSize)
+	if err != nil {
+		log.Error().Err(err).Msg("cant generate share link token")
+		return "", models.ShareLink{}, err
+	}
+
+	encryptedData, err := s.shareLinkCryptographer(token).Encrypt(encodedSecret)
+	if err != nil {
+		log.Error().Err(err).Msg("cant encrypt share link")
+		return "", models.ShareLink{}, err
+	}
+
+	link, err := s.secretsRepo.CreateShareLink(models.ShareLink{
+		ExpiresAt:      time.Now().Add(ttl),
+		SecretName:     metadata.Name,
+		SecretType:     metadata.Type,
+		UserID:         userID,
+		MaxRedemptions: maxRedemptions,
+	}, shareLinkTokenHash(token), encryptedData)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create share link")
+		return "", models.ShareLink{}, err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), link, nil
+}
+
+// RedeemShareLink returns snapshot of the secret from the link. It doesn't require authentication,
+// every call uses one redemption of the link.
+func (s *SecretsManager) RedeemShareLink(encodedToken string) (models.Secret, models.ShareLink, error) {
+	token, err := decodeShareLinkToken(encodedToken)
+	if err != nil {
+		return nil, models.ShareLink{}, storage.NewShareLinkNotFoundError(err)
+	}
+
+	encryptedData, link, err := s.secretsRepo.RedeemShareLink(shareLinkTokenHash(token))
+	if err != nil {
+		return nil, link, err
+	}
+
+	encodedSecret, err := s.shareLinkCryptographer(token).Decrypt(encryptedData)
+	if err != nil {
+		log.Error().Err(err).Msg("cant decrypt share link")
+		return nil, link, err
+	}
+
+	secret := models.NewSecret(link.SecretType)
+	if secret == nil {
+		return nil, link, ErrUnknownSecretType
+	}
+
+	err = gob.NewDecoder(bytes.NewReader(encodedSecret)).Decode(secret)
+	if err != nil {
+		log.Error().Err(err).Msg("cant decode secret")
+		return nil, link, err
+	}
+
+	log.Info().
+		Str("audit", "share_link_redeemed").
+		Int("user_id", link.UserID).
+		Int64("share_link_id", link.ID).
+		Int("redemptions", link.Redemptions).
+		Msg("share link redeemed")
+
+	return secret, link, nil
+}
+
+// PurgeShareLinks removes share links that can't be redeemed anymore.
+func (s *SecretsManager) PurgeShareLinks() error {
+	purged, err := s.secretsRepo.PurgeShareLinks()
+	if err != nil {
+		log.Error().Err(err).Msg("cant purge share links")
+		return err
+	}
+
+	log.Info().Int64("purged", purged).Msg("purged share links")
+
+	return nil
+}
+
+// ShareLinkTokenHash returns hash that share link with encoded token is found by. Invalid tokens return nil.
+func ShareLinkTokenHash(encodedToken string) []byte {
+	token, err := decodeShareLinkToken(encodedToken)
+	if err != nil {
+		return nil
+	}
+
+	return shareLinkTokenHash(token)
+}
+
+func decodeShareLinkToken(encodedToken string) ([]byte, error) {
+	token, err := base64.RawURLEncoding.DecodeString(encodedToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) != shareLinkTokenSize {
+		return nil, errors.New("invalid size of share link token")
+	}
+
+	return token, nil
+}
+
+func shareLinkTokenHash(token []byte) []byte {
+	hash := sha256.Sum256(token)
+	return hash[:]
+}
+
+// shareLinkCryptographer returns cryptographer with the key derived from share link token.
+func (s *SecretsManager) shareLinkCryptographer(token []byte) Cryptographer {
+	key := sha256.Sum256(append([]byte(shareLinkKeyPrefix), token...))
+	return &GCMAESCryptographer{Random: s.random, Key: key[:]}
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/rs/zerolog/log"
+)
+
+// ShareSecret gives user with username access to the secret of metadata.UserID.
+// Sharing the secret with the same user again changes the permission.
+func (s *SecretsManager) ShareSecret(metadata models.SecretMetadata, username string, permission models.SharePermission) error {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return NewValidationError("username", "username is required")
+	}
+	if permission != models.SharePermissionRead && permission != models.SharePermissionWrite {
+		return NewValidationError("permission", "permission must be read-only or read-write")
+	}
+
+	// other users don't have vault key of the owner
+	_, current, err := s.secretsRepo.FindSecretData(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get shared secret")
+		return err
+	}
+	if current.ClientEncrypted {
+		return ErrClientEncrypted
+	}
+
+	err = s.secretsRepo.ShareSecret(metadata, username, permission)
+	if err != nil {
+		log.Error().Err(err).Msg("cant share secret")
+		return err
+	}
+
+	return nil
+}
+
+// RevokeShare removes access of user with username to the secret of metadata.UserID.
+func (s *SecretsManager) RevokeShare(metadata models.SecretMetadata, username string) error {
+	err := s.secretsRepo.RevokeShare(metadata, strings.TrimSpace(username))
+	if err != nil {
+		log.Error().Err(err).Msg("cant revoke share")
+		return err
+	}
+
+	return nil
+}
+
+// ListShares returns users that the secret of metadata.UserID is shared with.
+func (s *SecretsManager) ListShares(metadata models.SecretMetadata) ([]models.SecretShare, error) {
+	shares, err := s.secretsRepo.ListShares(metadata)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list shares")
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+// ListSharedWithMe returns secrets that other users shared with the user.
+// Their metadata can be used to get, reveal and, with read-write permission, update them.
+func (s *SecretsManager) ListSharedWithMe(userID int) ([]models.SharedSecret, error) {
+	secrets, err := s.secretsRepo.ListSharedWithMe(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list shared secrets")
+		return nil, err
+	}
+
+	return secrets, nil
+}
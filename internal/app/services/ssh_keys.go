@@ -0,0 +1,270 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	minRSAKeyBits = 2048
+	rsaKeyBits    = 4096
+
+	openSSHKeyMagic     = "openssh-key-v1\x00"
+	openSSHPEMType      = "OPENSSH PRIVATE KEY"
+	openSSHKeyBlockSize = 8
+)
+
+// Algorithms of generated SSH keys.
+const (
+	SSHKeyAlgorithmEd25519 = "ed25519"
+	SSHKeyAlgorithmRSA     = "rsa"
+	SSHKeyAlgorithmECDSA   = "ecdsa"
+)
+
+// SSHKeyValidator checks that SSH key secrets contain supported private keys
+// and fills their public key, fingerprint and key type from the private key.
+type SSHKeyValidator struct{}
+
+func (SSHKeyValidator) Validate(secret models.Secret, _ models.SecretMetadata) error {
+	key, ok := secret.(*models.SSHKeySecret)
+	if !ok {
+		return nil
+	}
+
+	publicKey, err := ParseSSHPublicKey(key.PrivateKey, key.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	key.KeyType = publicKey.Type()
+	key.Fingerprint = ssh.FingerprintSHA256(publicKey)
+	key.PublicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+	if key.Comment != "" {
+		key.PublicKey += " " + key.Comment
+	}
+
+	return nil
+}
+
+// ParseSSHPublicKey returns public key of PEM encoded private key.
+// Passphrase is required for protected keys unless their format stores public key unencrypted.
+func ParseSSHPublicKey(privateKey string, passphrase string) (ssh.PublicKey, error) {
+	if strings.TrimSpace(privateKey) == "" {
+		return nil, NewValidationError("private_key", "private key is required")
+	}
+
+	var rawKey interface{}
+	var err error
+	if passphrase == "" {
+		rawKey, err = ssh.ParseRawPrivateKey([]byte(privateKey))
+	} else {
+		rawKey, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	}
+
+	var missingErr *ssh.PassphraseMissingError
+	if errors.As(err, &missingErr) {
+		if missingErr.PublicKey == nil {
+			return nil, NewValidationError("passphrase", "key is passphrase protected")
+		}
+		return missingErr.PublicKey, checkSSHPublicKey(missingErr.PublicKey)
+	}
+	if errors.Is(err, x509.IncorrectPasswordError) {
+		return nil, NewValidationError("passphrase", "passphrase is incorrect")
+	}
+	if err != nil {
+		return nil, NewValidationError("private_key", fmt.Sprintf("cannot parse private key: %v", err))
+	}
+
+	signer, err := ssh.NewSignerFromKey(rawKey)
+	if err != nil {
+		return nil, NewValidationError("private_key", fmt.Sprintf("unsupported private key: %v", err))
+	}
+
+	return signer.PublicKey(), checkSSHPublicKey(signer.PublicKey())
+}
+
+func checkSSHPublicKey(publicKey ssh.PublicKey) error {
+	switch publicKey.Type() {
+	case ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521:
+		return nil
+	case ssh.KeyAlgoRSA:
+		cryptoKey, ok := publicKey.(ssh.CryptoPublicKey)
+		if !ok {
+			return nil
+		}
+		rsaKey, ok := cryptoKey.CryptoPublicKey().(*rsa.PublicKey)
+		if ok && rsaKey.N.BitLen() < minRSAKeyBits {
+			return NewValidationError("private_key", fmt.Sprintf("rsa key must be at least %d bits", minRSAKeyBits))
+		}
+		return nil
+	}
+
+	return NewValidationError("private_key", fmt.Sprintf("unsupported key type %s", publicKey.Type()))
+}
+
+// GenerateSSHKey generates new key pair and returns its private key in OpenSSH format.
+func GenerateSSHKey(algorithm string, comment string) (string, error) {
+	var privateKey crypto.PrivateKey
+	var err error
+
+	switch algorithm {
+	case SSHKeyAlgorithmEd25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case SSHKeyAlgorithmRSA:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case SSHKeyAlgorithmECDSA:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return "", fmt.Errorf("unknown key algorithm %q", algorithm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := MarshalOpenSSHPrivateKey(privateKey, comment)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// ExportOpenSSHPrivateKey converts unencrypted private key to OpenSSH format.
+// OpenSSH and passphrase protected keys are returned as is, ssh reads them as well.
+func ExportOpenSSHPrivateKey(privateKey string, comment string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("no private key found")
+	}
+	if block.Type == openSSHPEMType {
+		return []byte(privateKey), nil
+	}
+
+	rawKey, err := ssh.ParseRawPrivateKey([]byte(privateKey))
+	var missingErr *ssh.PassphraseMissingError
+	if errors.As(err, &missingErr) {
+		return []byte(privateKey), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalOpenSSHPrivateKey(rawKey, comment)
+}
+
+// MarshalOpenSSHPrivateKey encodes unencrypted private key in openssh-key-v1 format
+// as described in PROTOCOL.key of OpenSSH.
+func MarshalOpenSSHPrivateKey(privateKey crypto.PrivateKey, comment string) ([]byte, error) {
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := signer.PublicKey()
+
+	var keyFields []byte
+	switch key := privateKey.(type) {
+	case ed25519.PrivateKey:
+		keyFields = ssh.Marshal(struct {
+			Public  []byte
+			Private []byte
+		}{
+			Public:  key.Public().(ed25519.PublicKey),
+			Private: key,
+		})
+	case *ed25519.PrivateKey:
+		return MarshalOpenSSHPrivateKey(*key, comment)
+	case *rsa.PrivateKey:
+		key.Precompute()
+		keyFields = ssh.Marshal(struct {
+			N    *big.Int
+			E    *big.Int
+			D    *big.Int
+			Iqmp *big.Int
+			P    *big.Int
+			Q    *big.Int
+		}{
+			N:    key.N,
+			E:    big.NewInt(int64(key.E)),
+			D:    key.D,
+			Iqmp: key.Precomputed.Qinv,
+			P:    key.Primes[0],
+			Q:    key.Primes[1],
+		})
+	case *ecdsa.PrivateKey:
+		curve, ok := openSSHCurveNames[key.Curve]
+		if !ok {
+			return nil, errors.New("unsupported ecdsa curve")
+		}
+		keyFields = ssh.Marshal(struct {
+			Curve string
+			Q     []byte
+			D     *big.Int
+		}{
+			Curve: curve,
+			Q:     elliptic.Marshal(key.Curve, key.X, key.Y), //nolint:staticcheck
+			D:     key.D,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+
+	var checkBytes [4]byte
+	if _, err = rand.Read(checkBytes[:]); err != nil {
+		return nil, err
+	}
+	check := binary.BigEndian.Uint32(checkBytes[:])
+
+	privateBlock := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Rest    []byte `ssh:"rest"`
+	}{
+		Check1:  check,
+		Check2:  check,
+		KeyType: publicKey.Type(),
+		Rest:    keyFields,
+	})
+	privateBlock = append(privateBlock, ssh.Marshal(struct{ Comment string }{comment})...)
+	for i := 1; len(privateBlock)%openSSHKeyBlockSize != 0; i++ {
+		privateBlock = append(privateBlock, byte(i))
+	}
+
+	encoded := append([]byte(openSSHKeyMagic), ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       publicKey.Marshal(),
+		PrivKeyBlock: privateBlock,
+	})...)
+
+	return pem.EncodeToMemory(&pem.Block{Type: openSSHPEMType, Bytes: encoded}), nil
+}
+
+//nolint:gochecknoglobals
+var openSSHCurveNames = map[elliptic.Curve]string{
+	elliptic.P256(): "nistp256",
+	elliptic.P384(): "nistp384",
+	elliptic.P521(): "nistp521",
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestMarshalOpenSSHPrivateKeyRoundTrip(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("cant generate ed25519 key: %v", err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatalf("cant generate rsa key: %v", err)
+	}
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("cant generate ecdsa key: %v", err)
+	}
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("cant generate ecdsa key: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		key  crypto.PrivateKey
+	}{
+		{name: "ed25519", key: ed25519Key},
+		{name: "rsa", key: rsaKey},
+		{name: "ecdsa p256", key: p256Key},
+		{name: "ecdsa p384", key: p384Key},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := MarshalOpenSSHPrivateKey(tt.key, "user@host")
+			if err != nil {
+				t.Fatalf("cant marshal key: %v", err)
+			}
+
+			parsed, err := ssh.ParseRawPrivateKey(encoded)
+			if err != nil {
+				t.Fatalf("cant parse marshaled key: %v", err)
+			}
+			if key, ok := parsed.(*ed25519.PrivateKey); ok {
+				parsed = *key
+			}
+
+			original := tt.key.(interface{ Equal(crypto.PrivateKey) bool })
+			if !original.Equal(parsed) {
+				t.Errorf("parsed key %T differs from marshaled one", parsed)
+			}
+		})
+	}
+}
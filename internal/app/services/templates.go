@@ -0,0 +1,185 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/belamov/ypgo-password-manager/internal/app/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// TemplateDateLayout is the layout of values of date fields.
+const TemplateDateLayout = "2006-01-02"
+
+type TemplatesManagerInterface interface {
+	CreateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error)
+	UpdateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error)
+	GetTemplate(userID int, templateID int64) (*models.SecretTemplate, error)
+	ListTemplates(userID int) ([]models.SecretTemplate, error)
+	DeleteTemplate(userID int, templateID int64) error
+}
+
+// TemplatesManager manages user defined templates and validates custom secrets against them.
+type TemplatesManager struct {
+	templatesRepo storage.TemplateStorage
+}
+
+func NewTemplatesService(templatesStorage storage.TemplateStorage) *TemplatesManager {
+	return &TemplatesManager{templatesRepo: templatesStorage}
+}
+
+func (t *TemplatesManager) CreateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error) {
+	err := validateTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.templatesRepo.CreateTemplate(template)
+	if err != nil {
+		log.Error().Err(err).Msg("cant create template")
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateTemplate replaces name and fields of the template.
+// Secrets that were saved with previous fields are validated against new fields on next save.
+func (t *TemplatesManager) UpdateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error) {
+	err := validateTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := t.templatesRepo.UpdateTemplate(template)
+	if err != nil {
+		log.Error().Err(err).Msg("cant update template")
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (t *TemplatesManager) GetTemplate(userID int, templateID int64) (*models.SecretTemplate, error) {
+	template, err := t.templatesRepo.FindTemplate(userID, templateID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant get template")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (t *TemplatesManager) ListTemplates(userID int) ([]models.SecretTemplate, error) {
+	templates, err := t.templatesRepo.ListTemplates(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant list templates")
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteTemplate deletes the template. Secrets saved with it stay readable,
+// but they can't be saved again until they are moved to another template.
+func (t *TemplatesManager) DeleteTemplate(userID int, templateID int64) error {
+	err := t.templatesRepo.DeleteTemplate(userID, templateID)
+	if err != nil {
+		log.Error().Err(err).Msg("cant delete template")
+		return err
+	}
+
+	return nil
+}
+
+// Validate checks that custom secret has all required fields of its template
+// and that values of the fields match their kinds.
+func (t *TemplatesManager) Validate(secret models.Secret, metadata models.SecretMetadata) error {
+	customSecret, ok := secret.(*models.CustomSecret)
+	if !ok {
+		return nil
+	}
+
+	template, err := t.templatesRepo.FindTemplate(metadata.UserID, customSecret.TemplateID)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(template.Fields))
+	for _, field := range template.Fields {
+		known[field.Name] = true
+
+		value := customSecret.Fields[field.Name]
+		if value == "" {
+			if field.Required {
+				return NewValidationError(field.Name, "field is required")
+			}
+			continue
+		}
+
+		err = validateFieldValue(field, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name := range customSecret.Fields {
+		if !known[name] {
+			return NewValidationError(name, fmt.Sprintf("field is not defined in template %q", template.Name))
+		}
+	}
+
+	return nil
+}
+
+func validateFieldValue(field models.TemplateField, value string) error {
+	switch field.Kind {
+	case models.FieldKindText, models.FieldKindConcealed:
+		if strings.ContainsAny(value, "\r\n") {
+			return NewValidationError(field.Name, "value must be a single line")
+		}
+	case models.FieldKindURL:
+		parsed, err := url.Parse(value)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return NewValidationError(field.Name, "value must be an absolute URL")
+		}
+	case models.FieldKindDate:
+		if _, err := time.Parse(TemplateDateLayout, value); err != nil {
+			return NewValidationError(field.Name, "value must be a date in format YYYY-MM-DD")
+		}
+	case models.FieldKindMultiline:
+	default:
+		return NewValidationError(field.Name, "field has unknown kind")
+	}
+
+	return nil
+}
+
+func validateTemplate(template models.SecretTemplate) error {
+	if strings.TrimSpace(template.Name) == "" {
+		return NewValidationError("name", "template name is required")
+	}
+	if len(template.Fields) == 0 {
+		return NewValidationError("fields", "template must have at least one field")
+	}
+
+	names := make(map[string]bool, len(template.Fields))
+	for _, field := range template.Fields {
+		if strings.TrimSpace(field.Name) == "" {
+			return NewValidationError("fields", "field name is required")
+		}
+		if names[field.Name] {
+			return NewValidationError(field.Name, "field name is not unique")
+		}
+		names[field.Name] = true
+
+		if field.Kind.String() == "" {
+			return NewValidationError(field.Name, "field has unknown kind")
+		}
+	}
+
+	return nil
+}
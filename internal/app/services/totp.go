@@ -0,0 +1,201 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	minTOTPDigits = 6
+	maxTOTPDigits = 8
+)
+
+// GetTOTPCode returns the current code of TOTP secret and time until the code expires.
+func (s *SecretsManager) GetTOTPCode(metadata models.SecretMetadata) (string, time.Duration, error) {
+	metadata.Type = models.SecretTypeTOTP
+
+	secret, _, err := s.decodeSecret(metadata)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if _, ok := secret.(*models.EncryptedSecret); ok {
+		return "", 0, ErrClientEncrypted
+	}
+	totp, ok := secret.(*models.TOTPSecret)
+	if !ok {
+		return "", 0, ErrUnknownSecretType
+	}
+
+	code, remaining, err := GenerateTOTPCode(totp, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("cant generate totp code")
+		return "", 0, err
+	}
+
+	return code, remaining, nil
+}
+
+// GenerateTOTPCode returns RFC 6238 code that is valid at moment now and time until it expires.
+func GenerateTOTPCode(secret *models.TOTPSecret, now time.Time) (string, time.Duration, error) {
+	err := validateTOTP(secret)
+	if err != nil {
+		return "", 0, err
+	}
+
+	key, _ := decodeTOTPSeed(secret.Secret)
+	period := int64(secret.Period)
+	counter := now.Unix() / period
+
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(totpHash(secret.Algorithm), key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation, see RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < secret.Digits; i++ {
+		modulo *= 10
+	}
+
+	code := fmt.Sprintf("%0*d", secret.Digits, value%modulo)
+	nextCounterStart := time.Unix((counter+1)*period, 0)
+
+	return code, nextCounterStart.Sub(now), nil
+}
+
+// ParseOTPAuthURI parses otpauth://totp/ URI as exported by authenticator apps.
+// Missing parameters are set to their defaults.
+func ParseOTPAuthURI(uri string) (*models.TOTPSecret, error) {
+	parsed, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || parsed.Scheme != "otpauth" {
+		return nil, NewValidationError("uri", "uri must start with otpauth://")
+	}
+	if parsed.Host != "totp" {
+		return nil, NewValidationError("uri", "only totp uris are supported")
+	}
+
+	query := parsed.Query()
+	secret := &models.TOTPSecret{
+		Secret:    query.Get("secret"),
+		Algorithm: models.DefaultTOTPAlgorithm,
+		Digits:    models.DefaultTOTPDigits,
+		Period:    models.DefaultTOTPPeriod,
+	}
+
+	label := strings.TrimPrefix(parsed.Path, "/")
+	issuer, account, found := strings.Cut(label, ":")
+	if found {
+		secret.Issuer = strings.TrimSpace(issuer)
+		secret.AccountName = strings.TrimSpace(account)
+	} else {
+		secret.AccountName = strings.TrimSpace(label)
+	}
+	if query.Has("issuer") {
+		secret.Issuer = query.Get("issuer")
+	}
+
+	if query.Has("algorithm") {
+		secret.Algorithm = parseTOTPAlgorithm(query.Get("algorithm"))
+	}
+	if query.Has("digits") {
+		secret.Digits, err = strconv.Atoi(query.Get("digits"))
+		if err != nil {
+			return nil, NewValidationError("digits", "digits must be a number")
+		}
+	}
+	if query.Has("period") {
+		secret.Period, err = strconv.Atoi(query.Get("period"))
+		if err != nil {
+			return nil, NewValidationError("period", "period must be a number")
+		}
+	}
+
+	err = validateTOTP(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// TOTPValidator checks that TOTP secrets can be used to generate codes.
+type TOTPValidator struct{}
+
+func (TOTPValidator) Validate(secret models.Secret, _ models.SecretMetadata) error {
+	totp, ok := secret.(*models.TOTPSecret)
+	if !ok {
+		return nil
+	}
+
+	return validateTOTP(totp)
+}
+
+func validateTOTP(secret *models.TOTPSecret) error {
+	if secret.Secret == "" {
+		return NewValidationError("secret", "seed is required")
+	}
+	if _, err := decodeTOTPSeed(secret.Secret); err != nil {
+		return NewValidationError("secret", "seed must be base32 encoded")
+	}
+	if secret.Algorithm.String() == "" {
+		return NewValidationError("algorithm", "algorithm must be one of SHA1, SHA256, SHA512")
+	}
+	if secret.Digits < minTOTPDigits || secret.Digits > maxTOTPDigits {
+		return NewValidationError("digits", fmt.Sprintf("digits must be between %d and %d", minTOTPDigits, maxTOTPDigits))
+	}
+	if secret.Period <= 0 {
+		return NewValidationError("period", "period must be positive")
+	}
+
+	return nil
+}
+
+// decodeTOTPSeed decodes base32 seed. Case, spaces and padding are ignored as authenticator apps do.
+func decodeTOTPSeed(seed string) ([]byte, error) {
+	seed = strings.ToUpper(strings.ReplaceAll(seed, " ", ""))
+	seed = strings.TrimRight(seed, "=")
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(seed)
+}
+
+func parseTOTPAlgorithm(name string) models.TOTPAlgorithm {
+	for _, algorithm := range []models.TOTPAlgorithm{
+		models.TOTPAlgorithmSHA1,
+		models.TOTPAlgorithmSHA256,
+		models.TOTPAlgorithmSHA512,
+	} {
+		if strings.EqualFold(algorithm.String(), name) {
+			return algorithm
+		}
+	}
+	return 0
+}
+
+func totpHash(algorithm models.TOTPAlgorithm) func() hash.Hash {
+	switch algorithm {
+	case models.TOTPAlgorithmSHA256:
+		return sha256.New
+	case models.TOTPAlgorithmSHA512:
+		return sha512.New
+	}
+	return sha1.New
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+// TestGenerateTOTPCode checks test vectors of RFC 6238 appendix B.
+func TestGenerateTOTPCode(t *testing.T) {
+	seeds := map[models.TOTPAlgorithm]string{
+		models.TOTPAlgorithmSHA1:   "12345678901234567890",
+		models.TOTPAlgorithmSHA256: "12345678901234567890123456789012",
+		models.TOTPAlgorithmSHA512: "1234567890123456789012345678901234567890123456789012345678901234",
+	}
+
+	tests := []struct {
+		unix      int64
+		algorithm models.TOTPAlgorithm
+		want      string
+	}{
+		{unix: 59, algorithm: models.TOTPAlgorithmSHA1, want: "94287082"},
+		{unix: 59, algorithm: models.TOTPAlgorithmSHA256, want: "46119246"},
+		{unix: 59, algorithm: models.TOTPAlgorithmSHA512, want: "90693936"},
+		{unix: 1111111109, algorithm: models.TOTPAlgorithmSHA1, want: "07081804"},
+		{unix: 1111111109, algorithm: models.TOTPAlgorithmSHA256, want: "68084774"},
+		{unix: 1111111109, algorithm: models.TOTPAlgorithmSHA512, want: "25091201"},
+		{unix: 1111111111, algorithm: models.TOTPAlgorithmSHA1, want: "14050471"},
+		{unix: 1111111111, algorithm: models.TOTPAlgorithmSHA256, want: "67062674"},
+		{unix: 1111111111, algorithm: models.TOTPAlgorithmSHA512, want: "99943326"},
+		{unix: 1234567890, algorithm: models.TOTPAlgorithmSHA1, want: "89005924"},
+		{unix: 1234567890, algorithm: models.TOTPAlgorithmSHA256, want: "91819424"},
+		{unix: 1234567890, algorithm: models.TOTPAlgorithmSHA512, want: "93441116"},
+		{unix: 2000000000, algorithm: models.TOTPAlgorithmSHA1, want: "69279037"},
+		{unix: 2000000000, algorithm: models.TOTPAlgorithmSHA256, want: "90698825"},
+		{unix: 2000000000, algorithm: models.TOTPAlgorithmSHA512, want: "38618901"},
+		{unix: 20000000000, algorithm: models.TOTPAlgorithmSHA1, want: "65353130"},
+		{unix: 20000000000, algorithm: models.TOTPAlgorithmSHA256, want: "77737706"},
+		{unix: 20000000000, algorithm: models.TOTPAlgorithmSHA512, want: "47863826"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm.String()+"/"+time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			secret := &models.TOTPSecret{
+				Secret:    base32.StdEncoding.EncodeToString([]byte(seeds[tt.algorithm])),
+				Algorithm: tt.algorithm,
+				Digits:    8,
+				Period:    30,
+			}
+
+			code, remaining, err := GenerateTOTPCode(secret, time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("cant generate code: %v", err)
+			}
+			if code != tt.want {
+				t.Errorf("code = %s, want %s", code, tt.want)
+			}
+			if wantRemaining := time.Duration(30-tt.unix%30) * time.Second; remaining != wantRemaining {
+				t.Errorf("remaining = %v, want %v", remaining, wantRemaining)
+			}
+		})
+	}
+}
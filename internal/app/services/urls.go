@@ -0,0 +1,157 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/publicsuffix"
+)
+
+// PasswordMatch is a password secret that matches searched URL.
+type PasswordMatch struct {
+	Secret   *models.PasswordSecret
+	Metadata models.SecretMetadata
+}
+
+// FindByURL returns password secrets of personal vault of the user or of collection if collectionID is not zero
+// with at least one URL matching rawURL.
+// Matched secrets are returned unmasked, so every match is written to the audit log.
+func (s *SecretsManager) FindByURL(userID int, collectionID int64, rawURL string) ([]PasswordMatch, error) {
+	target, err := parseSiteURL(rawURL)
+	if err != nil {
+		return nil, NewValidationError("url", "invalid url")
+	}
+
+	passwords, err := s.listAllSecrets(userID, collectionID, models.SecretTypePassword)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []PasswordMatch
+	for _, metadata := range passwords {
+		secret, metadata, err := s.decodeSecret(metadata)
+		if err != nil {
+			return nil, err
+		}
+		password, ok := secret.(*models.PasswordSecret)
+		if !ok || !passwordMatchesURL(password, rawURL, target) {
+			continue
+		}
+
+		log.Info().
+			Str("audit", "secret_revealed").
+			Int("user_id", metadata.UserID).
+			Int64("secret_id", metadata.ID).
+			Str("secret_type", metadata.Type.String()).
+			Msg("secret found by url")
+
+		matches = append(matches, PasswordMatch{Secret: password, Metadata: metadata})
+	}
+
+	return matches, nil
+}
+
+func passwordMatchesURL(password *models.PasswordSecret, rawURL string, target *url.URL) bool {
+	for _, passwordURL := range password.URLs {
+		if MatchURL(passwordURL, rawURL, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchURL checks whether URL of password secret matches URL of a site.
+// Target is rawURL parsed with the same rules as URLs of password secrets.
+func MatchURL(passwordURL models.PasswordURL, rawURL string, target *url.URL) bool {
+	if passwordURL.Match == models.URLMatchRegex {
+		re, err := regexp.Compile(passwordURL.URL)
+		return err == nil && re.MatchString(rawURL)
+	}
+
+	rule, err := parseSiteURL(passwordURL.URL)
+	if err != nil {
+		return false
+	}
+
+	switch passwordURL.Match {
+	case models.URLMatchHost:
+		return rule.Host == target.Host
+	case models.URLMatchPrefix:
+		return strings.HasPrefix(target.String(), rule.String())
+	default:
+		return baseDomain(rule.Hostname()) == baseDomain(target.Hostname())
+	}
+}
+
+// PasswordURLsValidator checks URLs of password secrets and sets default match rule.
+type PasswordURLsValidator struct{}
+
+func (v PasswordURLsValidator) Validate(secret models.Secret, _ models.SecretMetadata) error {
+	password, ok := secret.(*models.PasswordSecret)
+	if !ok {
+		return nil
+	}
+
+	urls := password.URLs[:0]
+	for _, passwordURL := range password.URLs {
+		passwordURL.URL = strings.TrimSpace(passwordURL.URL)
+		if passwordURL.URL == "" {
+			continue
+		}
+		if passwordURL.Match == 0 {
+			passwordURL.Match = models.DefaultURLMatch
+		}
+
+		switch passwordURL.Match {
+		case models.URLMatchRegex:
+			if _, err := regexp.Compile(passwordURL.URL); err != nil {
+				return NewValidationError("urls", fmt.Sprintf("invalid regular expression %q", passwordURL.URL))
+			}
+		case models.URLMatchBaseDomain, models.URLMatchHost, models.URLMatchPrefix:
+			if _, err := parseSiteURL(passwordURL.URL); err != nil {
+				return NewValidationError("urls", fmt.Sprintf("invalid url %q", passwordURL.URL))
+			}
+		default:
+			return NewValidationError("urls", fmt.Sprintf("unknown match rule %d", passwordURL.Match))
+		}
+
+		urls = append(urls, passwordURL)
+	}
+	password.URLs = urls
+
+	return nil
+}
+
+// parseSiteURL parses URL adding https scheme to bare hosts like gitlab.internal.
+// Host is lowercased, so hosts can be compared directly.
+func parseSiteURL(rawURL string) (*url.URL, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Hostname() == "" {
+		return nil, fmt.Errorf("url %q has no host", rawURL)
+	}
+	parsed.Host = strings.ToLower(parsed.Host)
+
+	return parsed, nil
+}
+
+// baseDomain returns registrable domain of host. Hosts without one, like IP addresses
+// and localhost, are returned as is.
+func baseDomain(host string) string {
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+func TestMatchURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		passwordURL models.PasswordURL
+		rawURL      string
+		want        bool
+	}{
+		{
+			name:        "base domain matches subdomain",
+			passwordURL: models.PasswordURL{URL: "example.com", Match: models.URLMatchBaseDomain},
+			rawURL:      "https://gitlab.example.com/users/sign_in",
+			want:        true,
+		},
+		{
+			name:        "base domain respects public suffixes",
+			passwordURL: models.PasswordURL{URL: "alice.github.io", Match: models.URLMatchBaseDomain},
+			rawURL:      "https://bob.github.io",
+			want:        false,
+		},
+		{
+			name:        "base domain of other domain",
+			passwordURL: models.PasswordURL{URL: "example.com", Match: models.URLMatchBaseDomain},
+			rawURL:      "https://example.org",
+			want:        false,
+		},
+		{
+			name:        "base domain of ip address",
+			passwordURL: models.PasswordURL{URL: "http://192.168.1.1:8080", Match: models.URLMatchBaseDomain},
+			rawURL:      "192.168.1.1",
+			want:        true,
+		},
+		{
+			name:        "host ignores case",
+			passwordURL: models.PasswordURL{URL: "Gitlab.Example.com", Match: models.URLMatchHost},
+			rawURL:      "https://gitlab.example.com/explore",
+			want:        true,
+		},
+		{
+			name:        "host of subdomain",
+			passwordURL: models.PasswordURL{URL: "example.com", Match: models.URLMatchHost},
+			rawURL:      "https://gitlab.example.com",
+			want:        false,
+		},
+		{
+			name:        "host with other port",
+			passwordURL: models.PasswordURL{URL: "example.com:8443", Match: models.URLMatchHost},
+			rawURL:      "https://example.com",
+			want:        false,
+		},
+		{
+			name:        "prefix of path",
+			passwordURL: models.PasswordURL{URL: "https://example.com/admin", Match: models.URLMatchPrefix},
+			rawURL:      "https://example.com/admin/users",
+			want:        true,
+		},
+		{
+			name:        "prefix of other path",
+			passwordURL: models.PasswordURL{URL: "https://example.com/admin", Match: models.URLMatchPrefix},
+			rawURL:      "https://example.com/shop",
+			want:        false,
+		},
+		{
+			name:        "regex",
+			passwordURL: models.PasswordURL{URL: `^https://[a-z]+\.example\.com/login$`, Match: models.URLMatchRegex},
+			rawURL:      "https://shop.example.com/login",
+			want:        true,
+		},
+		{
+			name:        "invalid regex",
+			passwordURL: models.PasswordURL{URL: `(`, Match: models.URLMatchRegex},
+			rawURL:      "https://example.com",
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := parseSiteURL(tt.rawURL)
+			if err != nil {
+				t.Fatalf("cant parse url %s: %v", tt.rawURL, err)
+			}
+			if got := MatchURL(tt.passwordURL, tt.rawURL, target); got != tt.want {
+				t.Errorf("MatchURL(%s, %s) = %v, want %v", tt.passwordURL.URL, tt.rawURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSiteURL(t *testing.T) {
+	tests := []struct {
+		rawURL   string
+		wantHost string
+		wantErr  bool
+	}{
+		{rawURL: "gitlab.internal", wantHost: "gitlab.internal"},
+		{rawURL: " HTTPS://Example.COM:8443/path ", wantHost: "example.com:8443"},
+		{rawURL: "http://localhost", wantHost: "localhost"},
+		{rawURL: "https://", wantErr: true},
+		{rawURL: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rawURL, func(t *testing.T) {
+			parsed, err := parseSiteURL(tt.rawURL)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", parsed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cant parse url: %v", err)
+			}
+			if parsed.Host != tt.wantHost {
+				t.Errorf("host = %s, want %s", parsed.Host, tt.wantHost)
+			}
+		})
+	}
+}
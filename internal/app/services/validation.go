@@ -0,0 +1,67 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+// SecretValidator checks secret before it is saved.
+type SecretValidator interface {
+	Validate(secret models.Secret, metadata models.SecretMetadata) error
+}
+
+// ValidationError describes why secret or its part is invalid.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	if err.Field == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s: %s", err.Field, err.Message)
+}
+
+func NewValidationError(field string, message string) error {
+	return &ValidationError{
+		Field:   field,
+		Message: message,
+	}
+}
+
+// RegisterValidator makes PutSecret validate secrets of secretType with validator.
+// Several validators of the same type are called in order of registration.
+func (s *SecretsManager) RegisterValidator(secretType models.SecretType, validator SecretValidator) {
+	if s.validators == nil {
+		s.validators = make(map[models.SecretType][]SecretValidator)
+	}
+	s.validators[secretType] = append(s.validators[secretType], validator)
+}
+
+func (s *SecretsManager) validate(secret models.Secret, metadata models.SecretMetadata) error {
+	for _, validator := range s.validators[secret.Type()] {
+		err := validator.Validate(secret, metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateEncryptedSecret checks what server knows about secret that is encrypted by the client.
+// Files that are encrypted by the client are uploaded chunk by chunk with UploadFile, not as one payload.
+func validateEncryptedSecret(secret models.Secret) error {
+	if models.NewSecret(secret.Type()) == nil || secret.Type() == models.SecretTypeBinary {
+		return NewValidationError("type", "type of encrypted secret must be set")
+	}
+
+	data, _ := secret.ToBinary()
+	if len(data) == 0 {
+		return NewValidationError("payload", "encrypted payload is empty")
+	}
+
+	return nil
+}
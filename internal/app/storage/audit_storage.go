@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type AuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAuditRepository(ctx context.Context, dsn string) (*AuditRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditRepository{
+		pool: pool,
+	}, nil
+}
+
+// AddEvent appends event to the audit log. User of the event is found by username if UserID is not set.
+// Vault of the event is found by id of the secret or by share link if it is not set.
+func (repo *AuditRepository) AddEvent(event models.AuditEvent) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		`insert into audit_events (user_id, action, method, secret_id, secret_type, secret_name, client_address, outcome,
+		                          owner_id, collection_id)
+		values (
+			coalesce(nullif($1, 0), (select id from users where username=$2)),
+			$3, $4, nullif($5, 0), nullif($6, 0), nullif($7, ''), $8, $9,
+			coalesce(
+				nullif($10, 0),
+				(select user_id from secrets where id=nullif($5, 0)),
+				(select user_id from share_links where token_hash=$12)
+			),
+			coalesce(nullif($11::bigint, 0), (select collection_id from secrets where id=nullif($5, 0)))
+		)`,
+		event.UserID,
+		event.Username,
+		event.Action,
+		event.Method,
+		event.SecretID,
+		event.SecretType,
+		event.SecretName,
+		event.ClientAddress,
+		event.Outcome,
+		event.OwnerID,
+		event.CollectionID,
+		event.ShareLinkTokenHash,
+	)
+
+	return err
+}
+
+// ListEvents returns audit events of the user matching the filter, newest first. Events of other users
+// in the vault of the user and in collections where the user is admin or owner are listed with their username.
+func (repo *AuditRepository) ListEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	actions := make([]int, 0, len(filter.Actions))
+	for _, action := range filter.Actions {
+		actions = append(actions, int(action))
+	}
+
+	var from, to *time.Time
+	if !filter.From.IsZero() {
+		from = &filter.From
+	}
+	if !filter.To.IsZero() {
+		to = &filter.To
+	}
+
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select e.id, coalesce(e.user_id, 0), e.action, e.method, coalesce(e.secret_id, 0), coalesce(e.secret_type, 0),
+			coalesce(e.secret_name, ''), e.client_address, e.outcome, e.created_at,
+			coalesce(e.owner_id, 0), coalesce(e.collection_id, 0),
+			case when e.user_id=$1 then '' else coalesce(actors.username, 'anonymous') end
+		from audit_events e
+		left join users actors on actors.id = e.user_id
+		where (e.user_id=$1 or e.owner_id=$1 or e.collection_id in (
+				select c.id from collections c
+				join organization_members m on m.organization_id = c.organization_id
+				where m.user_id=$1 and m.role >= $7
+			))
+			and ($2::bigint = 0 or e.id < $2)
+			and ($3::timestamptz is null or e.created_at >= $3)
+			and ($4::timestamptz is null or e.created_at < $4)
+			and (cardinality($5::smallint[]) = 0 or e.action = any($5))
+		order by e.id desc
+		limit $6`,
+		filter.UserID,
+		filter.Cursor,
+		from,
+		to,
+		actions,
+		filter.Limit,
+		models.RoleAdmin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		err = rows.Scan(
+			&event.ID,
+			&event.UserID,
+			&event.Action,
+			&event.Method,
+			&event.SecretID,
+			&event.SecretType,
+			&event.SecretName,
+			&event.ClientAddress,
+			&event.Outcome,
+			&event.CreatedAt,
+			&event.OwnerID,
+			&event.CollectionID,
+			&event.Actor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type DataKeysRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewDataKeysRepository(ctx context.Context, dsn string) (*DataKeysRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataKeysRepository{
+		pool: pool,
+	}, nil
+}
+
+// CreateVaultKey saves data key of the vault of key.UserID or key.CollectionID.
+// If the vault already has a key, for example when it is created concurrently, the existing key is returned.
+func (repo *DataKeysRepository) CreateVaultKey(key models.DataKey) (models.DataKey, error) {
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`insert into data_keys (user_id, collection_id, wrapped_key) values (nullif($1, 0), nullif($2, 0), $3)
+		on conflict do nothing
+		returning id, created_at`,
+		key.UserID,
+		key.CollectionID,
+		key.WrappedKey,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return repo.FindVaultKey(key.UserID, key.CollectionID)
+	}
+
+	return key, err
+}
+
+// FindVaultKey returns current data key of personal vault of userID or of collection if collectionID is not zero.
+func (repo *DataKeysRepository) FindVaultKey(userID int, collectionID int64) (models.DataKey, error) {
+	key, err := scanDataKey(repo.pool.QueryRow(
+		context.Background(),
+		"select "+dataKeyColumns+" from data_keys where case when $2::bigint = 0 then user_id=$1 else collection_id=$2 end and retired_at is null",
+		userID,
+		collectionID,
+	))
+	if err == pgx.ErrNoRows {
+		return key, NewDataKeyNotFoundError(0, err)
+	}
+
+	return key, err
+}
+
+// FindDataKey returns data key by its id.
+func (repo *DataKeysRepository) FindDataKey(id int64) (models.DataKey, error) {
+	key, err := scanDataKey(repo.pool.QueryRow(
+		context.Background(),
+		"select "+dataKeyColumns+" from data_keys where id=$1",
+		id,
+	))
+	if err == pgx.ErrNoRows {
+		return key, NewDataKeyNotFoundError(id, err)
+	}
+
+	return key, err
+}
+
+// RetireVaultKey retires current data key of personal vault of userID or of collection if collectionID is not zero.
+// New key is created on the next use, data of retired key is reencrypted with it by ReencryptRetiredData.
+func (repo *DataKeysRepository) RetireVaultKey(userID int, collectionID int64) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		`update data_keys set retired_at=now()
+		where case when $2::bigint = 0 then user_id=$1 else collection_id=$2 end and retired_at is null`,
+		userID,
+		collectionID,
+	)
+
+	return err
+}
+
+// DeleteVaultKeys deletes current and retired data keys of personal vault of userID
+// or of collection if collectionID is not zero. Data encrypted with them can't be decrypted anymore.
+func (repo *DataKeysRepository) DeleteVaultKeys(userID int, collectionID int64) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		"delete from data_keys where case when $2::bigint = 0 then user_id=$1 else collection_id=$2 end",
+		userID,
+		collectionID,
+	)
+
+	return err
+}
+
+// DeleteUnusedRetiredKeys deletes retired data keys that no data is encrypted with.
+// Retired keys are locked first, so writers that hold lockDataKey on them are waited for,
+// and only then data is checked, so data that is being saved with them is not lost.
+func (repo *DataKeysRepository) DeleteUnusedRetiredKeys() (int64, error) {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(ctx, "select id from data_keys where retired_at is not null for update")
+	if err != nil {
+		return 0, err
+	}
+
+	// separate statement sees data committed by writers that were waited for above
+	result, err := tx.Exec(
+		ctx,
+		`delete from data_keys k
+		where k.retired_at is not null
+		  and not exists (select 1 from secrets where data_key_id = k.id)
+		  and not exists (select 1 from secret_revisions where data_key_id = k.id)
+		  and not exists (select 1 from secret_chunks where data_key_id = k.id)`,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), tx.Commit(ctx)
+}
+
+// RewrapDataKeys replaces wrapped keys of all data keys with the result of rewrap.
+// Keys that rewrap returns nil for are left as they are. Data that is encrypted with the master key itself
+// can't be rewrapped, so LegacyDataError is returned while there is any. It returns the number of rewrapped keys.
+func (repo *DataKeysRepository) RewrapDataKeys(rewrap func(key models.DataKey) ([]byte, error)) (int64, error) {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var legacyRows int64
+	err = tx.QueryRow(
+		ctx,
+		`select (select count(*) from secrets where data_key_id is null and not client_encrypted and not reencryption_failed)
+			+ (select count(*) from secret_revisions where data_key_id is null and not client_encrypted and not reencryption_failed)
+			+ (select count(*) from secret_chunks c join secrets s on s.id = c.secret_id
+				where c.data_key_id is null and not s.client_encrypted and not c.reencryption_failed)`,
+	).Scan(&legacyRows)
+	if err != nil {
+		return 0, err
+	}
+	if legacyRows > 0 {
+		return 0, NewLegacyDataError(legacyRows)
+	}
+
+	rows, err := tx.Query(ctx, "select "+dataKeyColumns+" from data_keys for update")
+	if err != nil {
+		return 0, err
+	}
+
+	var keys []models.DataKey
+	for rows.Next() {
+		key, err := scanDataKey(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var rewrapped int64
+	for _, key := range keys {
+		wrappedKey, err := rewrap(key)
+		if err != nil {
+			return 0, err
+		}
+		if wrappedKey == nil {
+			continue
+		}
+
+		_, err = tx.Exec(ctx, "update data_keys set wrapped_key=$1 where id=$2", wrappedKey, key.ID)
+		if err != nil {
+			return 0, err
+		}
+		rewrapped++
+	}
+
+	return rewrapped, tx.Commit(ctx)
+}
+
+// lockDataKey locks data key dataKeyID in tx until it is finished, so DeleteUnusedRetiredKeys
+// doesn't delete the key while data encrypted with it is being saved. Zero is the master key of the server.
+// DataKeyNotFoundError is returned if the key is already deleted.
+func lockDataKey(ctx context.Context, tx pgx.Tx, dataKeyID int64) error {
+	if dataKeyID == 0 {
+		return nil
+	}
+
+	var id int64
+	err := tx.QueryRow(ctx, "select id from data_keys where id=$1 for share", dataKeyID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return NewDataKeyNotFoundError(dataKeyID, err)
+	}
+
+	return err
+}
+
+const dataKeyColumns = "id, coalesce(user_id, 0), coalesce(collection_id, 0), wrapped_key, created_at"
+
+func scanDataKey(row pgx.Row) (models.DataKey, error) {
+	var key models.DataKey
+	err := row.Scan(&key.ID, &key.UserID, &key.CollectionID, &key.WrappedKey, &key.CreatedAt)
+
+	return key, err
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+func TestDeleteUnusedRetiredKeysWaitsForWriters(t *testing.T) {
+	dsn := testDSN(t)
+	ctx := context.Background()
+	repo, err := NewDataKeysRepository(ctx, dsn)
+	if err != nil {
+		t.Fatalf("cant init data keys repo: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		saveData bool
+		wantKept bool
+	}{
+		{
+			name:     "data is saved with the key",
+			saveData: true,
+			wantKept: true,
+		},
+		{
+			name:     "nothing is saved with the key",
+			saveData: false,
+			wantKept: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := testUser(t, dsn)
+			key, err := repo.CreateVaultKey(models.DataKey{UserID: userID, WrappedKey: []byte("wrapped key")})
+			if err != nil {
+				t.Fatalf("cant create data key: %v", err)
+			}
+
+			writer, err := repo.pool.Begin(ctx)
+			if err != nil {
+				t.Fatalf("cant begin writer transaction: %v", err)
+			}
+			defer writer.Rollback(ctx) //nolint:errcheck
+
+			if err = lockDataKey(ctx, writer, key.ID); err != nil {
+				t.Fatalf("cant lock data key: %v", err)
+			}
+			if err = repo.RetireVaultKey(userID, 0); err != nil {
+				t.Fatalf("cant retire data key: %v", err)
+			}
+
+			purged := make(chan error, 1)
+			go func() {
+				_, err := repo.DeleteUnusedRetiredKeys()
+				purged <- err
+			}()
+
+			select {
+			case err = <-purged:
+				t.Fatalf("purge must wait for the writer, finished with %v", err)
+			case <-time.After(200 * time.Millisecond):
+			}
+
+			if tt.saveData {
+				_, err = writer.Exec(
+					ctx,
+					"insert into secrets (secret_data, user_id, secret_type, secret_name, data_key_id) values ('data', $1, $2, 'secret', $3)",
+					userID,
+					models.SecretTypeText,
+					key.ID,
+				)
+				if err != nil {
+					t.Fatalf("cant save secret: %v", err)
+				}
+			}
+			if err = writer.Commit(ctx); err != nil {
+				t.Fatalf("cant commit writer transaction: %v", err)
+			}
+
+			if err = <-purged; err != nil {
+				t.Fatalf("cant delete unused retired keys: %v", err)
+			}
+
+			_, err = repo.FindDataKey(key.ID)
+			var notFoundErr *DataKeyNotFoundError
+			if kept := !errors.As(err, &notFoundErr); kept != tt.wantKept {
+				t.Fatalf("expected key kept %v, got error %v", tt.wantKept, err)
+			}
+		})
+	}
+}
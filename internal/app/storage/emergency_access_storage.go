@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const emergencyContactColumns = `c.owner_id, o.username, c.contact_id, u.username, c.wait_period, c.status, c.requested_at, c.created_at
+	from emergency_contacts c
+	join users o on o.id = c.owner_id
+	join users u on u.id = c.contact_id`
+
+type EmergencyAccessRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewEmergencyAccessRepository(ctx context.Context, dsn string) (*EmergencyAccessRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmergencyAccessRepository{
+		pool: pool,
+	}, nil
+}
+
+// NominateContact makes user with username an emergency contact of the owner.
+// Nominating the same user again changes the wait period and keeps state of the access.
+func (repo *EmergencyAccessRepository) NominateContact(ownerID int, username string, waitPeriod time.Duration) error {
+	ctx := context.Background()
+
+	var contactID int
+	err := repo.pool.QueryRow(ctx, "select id from users where username=$1", username).Scan(&contactID)
+	if err == pgx.ErrNoRows {
+		return NewUserNotFoundError(username, err)
+	}
+	if err != nil {
+		return err
+	}
+	if contactID == ownerID {
+		return NewEmergencyContactIsOwnerError(username)
+	}
+
+	_, err = repo.pool.Exec(
+		ctx,
+		`insert into emergency_contacts (owner_id, contact_id, wait_period, status) values ($1, $2, $3, $4)
+		on conflict (owner_id, contact_id) do update set wait_period=excluded.wait_period`,
+		ownerID,
+		contactID,
+		waitPeriod,
+		models.EmergencyAccessNominated,
+	)
+
+	return err
+}
+
+// FindContact returns emergency contact of the owner by username of the contact.
+func (repo *EmergencyAccessRepository) FindContact(ownerID int, username string) (*models.EmergencyContact, error) {
+	contact, err := repo.findContact("c.owner_id=$1 and u.username=$2", ownerID, username)
+	if err == pgx.ErrNoRows {
+		return nil, NewEmergencyContactNotFoundError("", username, err)
+	}
+
+	return contact, err
+}
+
+// FindGrantor returns emergency access of the contact to the vault of the owner with ownerName.
+func (repo *EmergencyAccessRepository) FindGrantor(contactID int, ownerName string) (*models.EmergencyContact, error) {
+	contact, err := repo.findContact("c.contact_id=$1 and o.username=$2", contactID, ownerName)
+	if err == pgx.ErrNoRows {
+		return nil, NewEmergencyContactNotFoundError(ownerName, "", err)
+	}
+
+	return contact, err
+}
+
+// FindEmergencyAccess returns emergency access of the contact to the vault of the owner.
+func (repo *EmergencyAccessRepository) FindEmergencyAccess(ownerID int, contactID int) (*models.EmergencyContact, error) {
+	contact, err := repo.findContact("c.owner_id=$1 and c.contact_id=$2", ownerID, contactID)
+	if err == pgx.ErrNoRows {
+		return nil, NewEmergencyContactNotFoundError("", "", err)
+	}
+
+	return contact, err
+}
+
+// UpdateContactStatus changes state of the access. Time of the request is saved when access is requested.
+func (repo *EmergencyAccessRepository) UpdateContactStatus(ownerID int, contactID int, status models.EmergencyAccessStatus) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		`update emergency_contacts
+		set status=$3, requested_at=case when $3=$4 then now() else requested_at end
+		where owner_id=$1 and contact_id=$2`,
+		ownerID,
+		contactID,
+		status,
+		models.EmergencyAccessRequested,
+	)
+
+	return err
+}
+
+func (repo *EmergencyAccessRepository) RemoveContact(ownerID int, contactID int) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		"delete from emergency_contacts where owner_id=$1 and contact_id=$2",
+		ownerID,
+		contactID,
+	)
+
+	return err
+}
+
+// ListContacts returns emergency contacts of the owner ordered by username.
+func (repo *EmergencyAccessRepository) ListContacts(ownerID int) ([]models.EmergencyContact, error) {
+	return repo.listContacts("c.owner_id=$1 order by u.username", ownerID)
+}
+
+// ListGrantors returns owners that nominated the user as emergency contact ordered by username.
+func (repo *EmergencyAccessRepository) ListGrantors(contactID int) ([]models.EmergencyContact, error) {
+	return repo.listContacts("c.contact_id=$1 order by o.username", contactID)
+}
+
+func (repo *EmergencyAccessRepository) findContact(condition string, args ...interface{}) (*models.EmergencyContact, error) {
+	row := repo.pool.QueryRow(context.Background(), "select "+emergencyContactColumns+" where "+condition, args...)
+
+	contact, err := scanEmergencyContact(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+func (repo *EmergencyAccessRepository) listContacts(condition string, args ...interface{}) ([]models.EmergencyContact, error) {
+	rows, err := repo.pool.Query(context.Background(), "select "+emergencyContactColumns+" where "+condition, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contacts []models.EmergencyContact
+	for rows.Next() {
+		contact, err := scanEmergencyContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+
+	return contacts, rows.Err()
+}
+
+func scanEmergencyContact(row pgx.Row) (models.EmergencyContact, error) {
+	var contact models.EmergencyContact
+
+	err := row.Scan(
+		&contact.OwnerID,
+		&contact.OwnerName,
+		&contact.ContactID,
+		&contact.ContactName,
+		&contact.WaitPeriod,
+		&contact.Status,
+		&contact.RequestedAt,
+		&contact.CreatedAt,
+	)
+
+	return contact, err
+}
@@ -51,7 +51,7 @@ type NotUniqueSecretError struct {
 }
 
 func (err *NotUniqueSecretError) Error() string {
-	return fmt.Sprintf("metadato of secret is not unique (%q)", err.Metadata)
+	return fmt.Sprintf("metadato of secret is not unique (%v)", err.Metadata)
 }
 
 func (err *NotUniqueSecretError) Unwrap() error {
@@ -71,7 +71,7 @@ type SecretNotFoundError struct {
 }
 
 func (err *SecretNotFoundError) Error() string {
-	return fmt.Sprintf("secret not found (%q)", err.Metadata)
+	return fmt.Sprintf("secret not found (%v)", err.Metadata)
 }
 
 func (err *SecretNotFoundError) Unwrap() error {
@@ -84,3 +84,359 @@ func NewSecretNotFoundError(metadata models.SecretMetadata, err error) error {
 		Metadata: metadata,
 	}
 }
+
+type SecretVersionConflictError struct {
+	Err           error
+	Metadata      models.SecretMetadata
+	ActualVersion int64
+}
+
+func (err *SecretVersionConflictError) Error() string {
+	return fmt.Sprintf(
+		"secret was changed concurrently: expected version %d, actual version %d",
+		err.Metadata.Version,
+		err.ActualVersion,
+	)
+}
+
+func (err *SecretVersionConflictError) Unwrap() error {
+	return err.Err
+}
+
+func NewSecretVersionConflictError(metadata models.SecretMetadata, actualVersion int64) error {
+	return &SecretVersionConflictError{
+		Metadata:      metadata,
+		ActualVersion: actualVersion,
+	}
+}
+
+type RevisionNotFoundError struct {
+	Err      error
+	Metadata models.SecretMetadata
+	Version  int64
+}
+
+func (err *RevisionNotFoundError) Error() string {
+	return fmt.Sprintf("revision %d of secret not found (%v)", err.Version, err.Metadata)
+}
+
+func (err *RevisionNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewRevisionNotFoundError(metadata models.SecretMetadata, version int64, err error) error {
+	return &RevisionNotFoundError{
+		Err:      err,
+		Metadata: metadata,
+		Version:  version,
+	}
+}
+
+type NotUniqueTemplateError struct {
+	Err  error
+	Name string
+}
+
+func (err *NotUniqueTemplateError) Error() string {
+	return fmt.Sprintf("template already exists: %s", err.Name)
+}
+
+func (err *NotUniqueTemplateError) Unwrap() error {
+	return err.Err
+}
+
+func NewNotUniqueTemplateError(name string, err error) error {
+	return &NotUniqueTemplateError{
+		Err:  err,
+		Name: name,
+	}
+}
+
+type TemplateNotFoundError struct {
+	Err        error
+	TemplateID int64
+}
+
+func (err *TemplateNotFoundError) Error() string {
+	return fmt.Sprintf("template not found: id = %d", err.TemplateID)
+}
+
+func (err *TemplateNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewTemplateNotFoundError(templateID int64, err error) error {
+	return &TemplateNotFoundError{
+		Err:        err,
+		TemplateID: templateID,
+	}
+}
+
+type NotUniqueFolderError struct {
+	Err  error
+	Path string
+}
+
+func (err *NotUniqueFolderError) Error() string {
+	return fmt.Sprintf("folder or secret already exists: %s", err.Path)
+}
+
+func (err *NotUniqueFolderError) Unwrap() error {
+	return err.Err
+}
+
+func NewNotUniqueFolderError(path string, err error) error {
+	return &NotUniqueFolderError{
+		Err:  err,
+		Path: path,
+	}
+}
+
+type FolderNotFoundError struct {
+	Err  error
+	Path string
+}
+
+func (err *FolderNotFoundError) Error() string {
+	return fmt.Sprintf("folder not found: %s", err.Path)
+}
+
+func (err *FolderNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewFolderNotFoundError(path string, err error) error {
+	return &FolderNotFoundError{
+		Err:  err,
+		Path: path,
+	}
+}
+
+type FolderNotEmptyError struct {
+	Path string
+}
+
+func (err *FolderNotEmptyError) Error() string {
+	return fmt.Sprintf("folder is not empty: %s", err.Path)
+}
+
+func NewFolderNotEmptyError(path string) error {
+	return &FolderNotEmptyError{Path: path}
+}
+
+type ShareNotFoundError struct {
+	Err      error
+	Username string
+	Metadata models.SecretMetadata
+}
+
+func (err *ShareNotFoundError) Error() string {
+	return fmt.Sprintf("secret %s of type %s is not shared with %s", err.Metadata.Name, err.Metadata.Type, err.Username)
+}
+
+func (err *ShareNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewShareNotFoundError(metadata models.SecretMetadata, username string, err error) error {
+	return &ShareNotFoundError{
+		Err:      err,
+		Username: username,
+		Metadata: metadata,
+	}
+}
+
+type ShareWithOwnerError struct {
+	Username string
+}
+
+func (err *ShareWithOwnerError) Error() string {
+	return fmt.Sprintf("secret can't be shared with its owner %s", err.Username)
+}
+
+func NewShareWithOwnerError(username string) error {
+	return &ShareWithOwnerError{Username: username}
+}
+
+type NotUniqueOrganizationError struct {
+	Err  error
+	Name string
+}
+
+func (err *NotUniqueOrganizationError) Error() string {
+	return fmt.Sprintf("organization already exists: %s", err.Name)
+}
+
+func (err *NotUniqueOrganizationError) Unwrap() error {
+	return err.Err
+}
+
+func NewNotUniqueOrganizationError(name string, err error) error {
+	return &NotUniqueOrganizationError{
+		Err:  err,
+		Name: name,
+	}
+}
+
+type MemberNotFoundError struct {
+	Err            error
+	Username       string
+	OrganizationID int64
+}
+
+func (err *MemberNotFoundError) Error() string {
+	return fmt.Sprintf("%s is not a member of organization %d", err.Username, err.OrganizationID)
+}
+
+func (err *MemberNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewMemberNotFoundError(organizationID int64, username string, err error) error {
+	return &MemberNotFoundError{
+		Err:            err,
+		Username:       username,
+		OrganizationID: organizationID,
+	}
+}
+
+type NotUniqueMemberError struct {
+	Err            error
+	Username       string
+	OrganizationID int64
+}
+
+func (err *NotUniqueMemberError) Error() string {
+	return fmt.Sprintf("%s is already a member of organization %d", err.Username, err.OrganizationID)
+}
+
+func (err *NotUniqueMemberError) Unwrap() error {
+	return err.Err
+}
+
+func NewNotUniqueMemberError(organizationID int64, username string, err error) error {
+	return &NotUniqueMemberError{
+		Err:            err,
+		Username:       username,
+		OrganizationID: organizationID,
+	}
+}
+
+type NotUniqueCollectionError struct {
+	Err  error
+	Name string
+}
+
+func (err *NotUniqueCollectionError) Error() string {
+	return fmt.Sprintf("collection already exists: %s", err.Name)
+}
+
+func (err *NotUniqueCollectionError) Unwrap() error {
+	return err.Err
+}
+
+func NewNotUniqueCollectionError(name string, err error) error {
+	return &NotUniqueCollectionError{
+		Err:  err,
+		Name: name,
+	}
+}
+
+// LastOwnerError is returned when the last owner of organization is removed or loses owner role.
+type LastOwnerError struct {
+	OrganizationID int64
+}
+
+func (err *LastOwnerError) Error() string {
+	return fmt.Sprintf("organization %d must have at least one owner", err.OrganizationID)
+}
+
+func NewLastOwnerError(organizationID int64) error {
+	return &LastOwnerError{OrganizationID: organizationID}
+}
+
+// ShareLinkNotFoundError is returned for unknown, expired and fully redeemed share links.
+type ShareLinkNotFoundError struct {
+	Err error
+}
+
+func (err *ShareLinkNotFoundError) Error() string {
+	return "share link not found, expired or already redeemed"
+}
+
+func (err *ShareLinkNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewShareLinkNotFoundError(err error) error {
+	return &ShareLinkNotFoundError{Err: err}
+}
+
+type EmergencyContactNotFoundError struct {
+	Err       error
+	OwnerName string
+	Username  string
+}
+
+func (err *EmergencyContactNotFoundError) Error() string {
+	if err.OwnerName != "" {
+		return fmt.Sprintf("you are not an emergency contact of %s", err.OwnerName)
+	}
+	return fmt.Sprintf("%s is not your emergency contact", err.Username)
+}
+
+func (err *EmergencyContactNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewEmergencyContactNotFoundError(ownerName string, username string, err error) error {
+	return &EmergencyContactNotFoundError{
+		Err:       err,
+		OwnerName: ownerName,
+		Username:  username,
+	}
+}
+
+type EmergencyContactIsOwnerError struct {
+	Username string
+}
+
+func (err *EmergencyContactIsOwnerError) Error() string {
+	return fmt.Sprintf("%s can't be an emergency contact of themselves", err.Username)
+}
+
+func NewEmergencyContactIsOwnerError(username string) error {
+	return &EmergencyContactIsOwnerError{Username: username}
+}
+
+type DataKeyNotFoundError struct {
+	Err error
+	ID  int64
+}
+
+func (err *DataKeyNotFoundError) Error() string {
+	return fmt.Sprintf("data key not found: id = %d", err.ID)
+}
+
+func (err *DataKeyNotFoundError) Unwrap() error {
+	return err.Err
+}
+
+func NewDataKeyNotFoundError(id int64, err error) error {
+	return &DataKeyNotFoundError{
+		Err: err,
+		ID:  id,
+	}
+}
+
+type LegacyDataError struct {
+	Rows int64
+}
+
+func (err *LegacyDataError) Error() string {
+	return fmt.Sprintf("%d rows are still encrypted with the master key, they must be reencrypted with data keys first", err.Rows)
+}
+
+func NewLegacyDataError(rows int64) error {
+	return &LegacyDataError{Rows: rows}
+}
@@ -0,0 +1,254 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FoldersRepository stores explicitly created folders of personal vaults and collections.
+// Secrets are placed in folders by their names, so folders that contain secrets exist even without rows in folders table.
+// Zero collectionID means personal vault of userID.
+type FoldersRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewFoldersRepository(ctx context.Context, dsn string) (*FoldersRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FoldersRepository{
+		pool: pool,
+	}, nil
+}
+
+// rowQuerier is implemented by both pool and transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func (repo *FoldersRepository) CreateFolder(userID int, collectionID int64, path string) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		"insert into folders (user_id, collection_id, path) values (case when $3::bigint = 0 then $1::int end, nullif($3, 0), $2)",
+		userID,
+		path,
+		collectionID,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return NewNotUniqueFolderError(path, err)
+		}
+	}
+	return err
+}
+
+// MoveFolder changes path of the folder with all its subfolders and secrets, including secrets in the trash.
+func (repo *FoldersRepository) MoveFolder(userID int, collectionID int64, from string, to string) error {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	exists, err := folderExists(ctx, tx, userID, collectionID, from)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return NewFolderNotFoundError(from, pgx.ErrNoRows)
+	}
+
+	exists, err = folderExists(ctx, tx, userID, collectionID, to)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return NewNotUniqueFolderError(to, nil)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`update folders set path = $3 || substr(path, length($2) + 1)
+		where ($4::bigint = 0 and user_id=$1 or collection_id=$4) and (path=$2 or starts_with(path, $2 || '/'))`,
+		userID,
+		from,
+		to,
+		collectionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`update secrets set secret_name = $3 || substr(secret_name, length($2) + 1)
+		where ($4::bigint = 0 and user_id=$1 or collection_id=$4) and starts_with(secret_name, $2 || '/')`,
+		userID,
+		from,
+		to,
+		collectionID,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return NewNotUniqueFolderError(to, err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteFolder removes empty folder with its empty subfolders.
+func (repo *FoldersRepository) DeleteFolder(userID int, collectionID int64, path string) error {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var notEmpty bool
+	err = tx.QueryRow(
+		ctx,
+		`select exists(
+			select 1 from secrets
+			where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and starts_with(secret_name, $2 || '/') and deleted_at is null
+		)`,
+		userID,
+		path,
+		collectionID,
+	).Scan(&notEmpty)
+	if err != nil {
+		return err
+	}
+	if notEmpty {
+		return NewFolderNotEmptyError(path)
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		"delete from folders where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and (path=$2 or starts_with(path, $2 || '/'))",
+		userID,
+		path,
+		collectionID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return NewFolderNotFoundError(path, pgx.ErrNoRows)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListFolder returns subfolders and active secrets that are directly in the folder. Empty path lists the root.
+func (repo *FoldersRepository) ListFolder(userID int, collectionID int64, path string) (models.FolderContents, error) {
+	ctx := context.Background()
+	contents := models.FolderContents{Path: path}
+
+	prefix := ""
+	if path != "" {
+		prefix = path + models.PathSeparator
+
+		exists, err := folderExists(ctx, repo.pool, userID, collectionID, path)
+		if err != nil {
+			return contents, err
+		}
+		if !exists {
+			return contents, NewFolderNotFoundError(path, pgx.ErrNoRows)
+		}
+	}
+
+	rows, err := repo.pool.Query(
+		ctx,
+		`select distinct split_part(substr(entry, length($2) + 1), '/', 1) as folder from (
+			select path as entry, true as is_folder from folders where ($3::bigint = 0 and user_id=$1 or collection_id=$3)
+			union all
+			select secret_name, false from secrets where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and deleted_at is null
+		) entries
+		where starts_with(entry, $2) and (is_folder or strpos(substr(entry, length($2) + 1), '/') > 0)
+		order by folder`,
+		userID,
+		prefix,
+		collectionID,
+	)
+	if err != nil {
+		return contents, err
+	}
+	for rows.Next() {
+		var folder string
+		if err = rows.Scan(&folder); err != nil {
+			rows.Close()
+			return contents, err
+		}
+		contents.Folders = append(contents.Folders, folder)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return contents, err
+	}
+
+	rows, err = repo.pool.Query(
+		ctx,
+		`select `+secretMetadataColumns+` from secrets
+		where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and deleted_at is null
+		  and starts_with(secret_name, $2) and strpos(substr(secret_name, length($2) + 1), '/') = 0
+		order by secret_name, secret_type`,
+		userID,
+		prefix,
+		collectionID,
+	)
+	if err != nil {
+		return contents, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var metadata models.SecretMetadata
+		metadata, err = scanSecretMetadata(rows)
+		if err != nil {
+			return contents, err
+		}
+		contents.Secrets = append(contents.Secrets, metadata)
+	}
+
+	return contents, rows.Err()
+}
+
+// folderExists checks whether folder was created or contains any folders or active secrets.
+func folderExists(ctx context.Context, querier rowQuerier, userID int, collectionID int64, path string) (bool, error) {
+	var exists bool
+	err := querier.QueryRow(
+		ctx,
+		`select exists(
+			select 1 from folders
+			where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and (path=$2 or starts_with(path, $2 || '/'))
+		) or exists(
+			select 1 from secrets
+			where ($3::bigint = 0 and user_id=$1 or collection_id=$3) and starts_with(secret_name, $2 || '/') and deleted_at is null
+		)`,
+		userID,
+		path,
+		collectionID,
+	).Scan(&exists)
+
+	return exists, err
+}
@@ -0,0 +1,4 @@
+alter table secrets
+    add column if not exists id bigserial primary key,
+    add column if not exists created_at timestamptz not null default now(),
+    add column if not exists updated_at timestamptz not null default now();
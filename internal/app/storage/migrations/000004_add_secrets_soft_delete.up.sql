@@ -0,0 +1,7 @@
+alter table secrets add column if not exists deleted_at timestamptz;
+
+alter table secrets drop constraint if exists secrets_user_id_secret_type_secret_name_key;
+
+create unique index if not exists secrets_user_id_secret_type_secret_name_active_key
+    on secrets (user_id, secret_type, secret_name)
+    where deleted_at is null;
@@ -0,0 +1 @@
+alter table secrets add column if not exists version bigint not null default 1;
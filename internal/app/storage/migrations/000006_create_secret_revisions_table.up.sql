@@ -0,0 +1,7 @@
+create table if not exists secret_revisions(
+    secret_id bigint not null references secrets(id) on delete cascade,
+    version bigint not null,
+    secret_data bytea not null,
+    created_at timestamptz not null,
+    primary key (secret_id, version)
+)
@@ -0,0 +1,6 @@
+create table if not exists secret_chunks(
+    secret_id bigint not null references secrets(id) on delete cascade,
+    chunk_index int not null,
+    chunk_data bytea not null,
+    primary key (secret_id, chunk_index)
+)
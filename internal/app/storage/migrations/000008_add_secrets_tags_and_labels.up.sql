@@ -0,0 +1,6 @@
+alter table secrets
+    add column if not exists tags text[] not null default '{}',
+    add column if not exists labels jsonb not null default '{}';
+
+create index if not exists secrets_tags_idx on secrets using gin (tags);
+create index if not exists secrets_labels_idx on secrets using gin (labels);
@@ -0,0 +1,9 @@
+create table if not exists secret_templates(
+    id bigserial primary key,
+    user_id int not null,
+    name varchar not null,
+    fields jsonb not null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    unique(user_id, name)
+)
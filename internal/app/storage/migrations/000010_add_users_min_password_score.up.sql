@@ -0,0 +1 @@
+alter table users add column if not exists min_password_score smallint not null default 0;
@@ -0,0 +1,5 @@
+alter table secrets
+    add column if not exists expires_at timestamptz,
+    add column if not exists rotate_every interval not null default '0';
+
+create index if not exists secrets_expires_at_idx on secrets (expires_at) where expires_at is not null;
@@ -0,0 +1,12 @@
+create table if not exists notifications(
+    id bigserial primary key,
+    user_id int not null,
+    secret_id bigint not null references secrets(id) on delete cascade,
+    reason varchar not null,
+    due_at timestamptz not null,
+    created_at timestamptz not null default now(),
+    read_at timestamptz,
+    unique(secret_id, reason, due_at)
+);
+
+create index if not exists notifications_unread_idx on notifications (user_id) where read_at is null;
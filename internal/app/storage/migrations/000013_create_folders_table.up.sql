@@ -0,0 +1,9 @@
+create table if not exists folders(
+    id bigserial primary key,
+    user_id int not null,
+    path varchar not null,
+    created_at timestamptz not null default now(),
+    unique(user_id, path)
+);
+
+create index if not exists secrets_user_id_secret_name_idx on secrets (user_id, secret_name);
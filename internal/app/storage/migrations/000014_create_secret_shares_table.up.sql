@@ -0,0 +1,9 @@
+create table if not exists secret_shares(
+    secret_id bigint not null references secrets(id) on delete cascade,
+    user_id int not null,
+    permission smallint not null,
+    created_at timestamptz not null default now(),
+    primary key (secret_id, user_id)
+);
+
+create index if not exists secret_shares_user_id_idx on secret_shares (user_id);
@@ -0,0 +1,33 @@
+create table if not exists organizations(
+    id bigserial primary key,
+    name varchar not null unique,
+    created_at timestamptz not null default now()
+);
+
+create table if not exists organization_members(
+    organization_id bigint not null references organizations(id) on delete cascade,
+    user_id int not null,
+    role smallint not null,
+    created_at timestamptz not null default now(),
+    primary key (organization_id, user_id)
+);
+
+create index if not exists organization_members_user_id_idx on organization_members (user_id);
+
+create table if not exists collections(
+    id bigserial primary key,
+    organization_id bigint not null references organizations(id) on delete cascade,
+    name varchar not null,
+    created_at timestamptz not null default now(),
+    unique(organization_id, name)
+);
+
+-- secrets of collections belong to organization, not to the user who created them
+alter table secrets
+    alter column user_id drop not null,
+    add column if not exists collection_id bigint references collections(id),
+    add constraint secrets_owner_check check ((user_id is null) <> (collection_id is null));
+
+create unique index if not exists secrets_collection_id_secret_type_secret_name_active_key
+    on secrets (collection_id, secret_type, secret_name)
+    where deleted_at is null and collection_id is not null;
@@ -0,0 +1,12 @@
+create table if not exists share_links(
+    id bigserial primary key,
+    token_hash bytea not null unique,
+    user_id int not null,
+    secret_type smallint not null,
+    secret_name varchar not null,
+    secret_data bytea not null,
+    max_redemptions int not null,
+    redemptions int not null default 0,
+    expires_at timestamptz not null,
+    created_at timestamptz not null default now()
+);
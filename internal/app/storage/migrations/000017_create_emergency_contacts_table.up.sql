@@ -0,0 +1,12 @@
+create table if not exists emergency_contacts(
+    owner_id int not null,
+    contact_id int not null,
+    wait_hours int not null,
+    status smallint not null,
+    requested_at timestamptz,
+    created_at timestamptz not null default now(),
+    primary key (owner_id, contact_id),
+    check (owner_id <> contact_id)
+);
+
+create index if not exists emergency_contacts_contact_id_idx on emergency_contacts (contact_id);
@@ -0,0 +1,26 @@
+create table if not exists audit_events(
+    id bigserial primary key,
+    user_id int,
+    action smallint not null,
+    method varchar not null,
+    secret_id bigint,
+    secret_type smallint,
+    secret_name varchar,
+    client_address varchar not null,
+    outcome varchar not null,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists audit_events_user_id_id_idx on audit_events (user_id, id);
+
+-- audit events are append-only
+create or replace function audit_events_append_only() returns trigger as $$
+begin
+    raise exception 'audit_events is append-only';
+end;
+$$ language plpgsql;
+
+drop trigger if exists audit_events_append_only on audit_events;
+create trigger audit_events_append_only
+    before update or delete on audit_events
+    for each row execute function audit_events_append_only();
@@ -0,0 +1,7 @@
+alter table users add column if not exists kdf_salt bytea;
+alter table users add column if not exists kdf_time int;
+alter table users add column if not exists kdf_memory int;
+alter table users add column if not exists kdf_threads smallint;
+
+alter table secrets add column if not exists client_encrypted boolean not null default false;
+alter table secret_revisions add column if not exists client_encrypted boolean not null default false;
@@ -0,0 +1,15 @@
+-- data keys encrypt secrets of one vault and are stored wrapped by the master key of the server,
+-- deleting the key of the vault makes its secrets unreadable
+create table if not exists data_keys(
+    id bigserial primary key,
+    user_id int unique references users(id) on delete cascade,
+    collection_id bigint unique references collections(id) on delete cascade,
+    wrapped_key bytea not null,
+    created_at timestamptz not null default now(),
+    check ((user_id is null) <> (collection_id is null))
+);
+
+-- data without data key is encrypted with the master key of the server
+alter table secrets add column if not exists data_key_id bigint;
+alter table secret_revisions add column if not exists data_key_id bigint;
+alter table secret_chunks add column if not exists data_key_id bigint;
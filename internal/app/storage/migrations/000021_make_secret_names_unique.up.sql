@@ -0,0 +1,22 @@
+-- names of active secrets are unique within a vault regardless of their type.
+-- secrets that were saved with the same name before are renamed, so they are not lost
+update secrets set secret_name = secret_name || '-' || id
+where deleted_at is null
+  and exists (
+    select 1 from secrets other
+    where other.deleted_at is null
+      and other.secret_name = secrets.secret_name
+      and other.id < secrets.id
+      and (other.user_id = secrets.user_id or other.collection_id = secrets.collection_id)
+  );
+
+drop index if exists secrets_user_id_secret_type_secret_name_active_key;
+drop index if exists secrets_collection_id_secret_type_secret_name_active_key;
+
+create unique index if not exists secrets_user_id_secret_name_active_key
+    on secrets (user_id, secret_name)
+    where deleted_at is null and user_id is not null;
+
+create unique index if not exists secrets_collection_id_secret_name_active_key
+    on secrets (collection_id, secret_name)
+    where deleted_at is null and collection_id is not null;
@@ -0,0 +1,7 @@
+-- owner of the vault or collection that action was made in, so owners see actions of other users with their secrets
+alter table audit_events
+    add column if not exists owner_id int,
+    add column if not exists collection_id bigint;
+
+create index if not exists audit_events_owner_id_id_idx on audit_events (owner_id, id);
+create index if not exists audit_events_collection_id_id_idx on audit_events (collection_id, id);
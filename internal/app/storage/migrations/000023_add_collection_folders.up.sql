@@ -0,0 +1,6 @@
+-- folders of collections belong to organization, like secrets of collections
+alter table folders
+    alter column user_id drop not null,
+    add column if not exists collection_id bigint references collections(id) on delete cascade,
+    add constraint folders_owner_check check ((user_id is null) <> (collection_id is null)),
+    add constraint folders_collection_id_path_key unique (collection_id, path);
@@ -0,0 +1,7 @@
+alter table emergency_contacts add column if not exists wait_period interval;
+
+update emergency_contacts set wait_period = make_interval(hours => wait_hours) where wait_period is null;
+
+alter table emergency_contacts
+    alter column wait_period set not null,
+    drop column if exists wait_hours;
@@ -0,0 +1,4 @@
+-- data that can't be decrypted for reencryption is marked, so it is not picked up again
+alter table secrets add column if not exists reencryption_failed boolean not null default false;
+alter table secret_revisions add column if not exists reencryption_failed boolean not null default false;
+alter table secret_chunks add column if not exists reencryption_failed boolean not null default false;
@@ -0,0 +1,18 @@
+-- rotated data keys are retired: they only decrypt secrets until these are reencrypted
+-- with the current key of the vault, then retired keys are deleted
+alter table data_keys add column if not exists retired_at timestamptz;
+
+alter table data_keys drop constraint if exists data_keys_user_id_key;
+alter table data_keys drop constraint if exists data_keys_collection_id_key;
+
+create unique index if not exists data_keys_user_id_current_key
+    on data_keys (user_id)
+    where retired_at is null and user_id is not null;
+
+create unique index if not exists data_keys_collection_id_current_key
+    on data_keys (collection_id)
+    where retired_at is null and collection_id is not null;
+
+create index if not exists secrets_data_key_id_idx on secrets (data_key_id);
+create index if not exists secret_revisions_data_key_id_idx on secret_revisions (data_key_id);
+create index if not exists secret_chunks_data_key_id_idx on secret_chunks (data_key_id);
@@ -0,0 +1,2 @@
+-- known value encrypted with vault key, client decrypts it to verify master password
+alter table users add column if not exists kdf_key_check bytea;
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type NotificationsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewNotificationsRepository(ctx context.Context, dsn string) (*NotificationsRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationsRepository{
+		pool: pool,
+	}, nil
+}
+
+// CreateNotifications saves notifications skipping ones that already exist for the same secret,
+// reason and due date. It returns the number of created notifications.
+func (repo *NotificationsRepository) CreateNotifications(notifications []models.Notification) (int64, error) {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var created int64
+	for _, notification := range notifications {
+		tag, err := tx.Exec(
+			ctx,
+			`insert into notifications (user_id, secret_id, reason, due_at) values ($1, $2, $3, $4)
+			on conflict (secret_id, reason, due_at) do nothing`,
+			notification.UserID,
+			notification.SecretID,
+			string(notification.Reason),
+			notification.DueAt,
+		)
+		if err != nil {
+			return 0, err
+		}
+		created += tag.RowsAffected()
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// TakeUnreadNotifications returns unread notifications of the user ordered by due date and marks them read.
+func (repo *NotificationsRepository) TakeUnreadNotifications(userID int) ([]models.Notification, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`update notifications n set read_at=now()
+		from secrets s
+		where s.id = n.secret_id and n.user_id=$1 and n.read_at is null
+		returning n.id, n.user_id, n.secret_id, s.secret_name, s.secret_type, n.reason, n.due_at, n.created_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []models.Notification
+	for rows.Next() {
+		var notification models.Notification
+		err = rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.SecretID,
+			&notification.SecretName,
+			&notification.SecretType,
+			&notification.Reason,
+			&notification.DueAt,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// update does not keep order of rows
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].DueAt.Before(notifications[j].DueAt)
+	})
+
+	return notifications, nil
+}
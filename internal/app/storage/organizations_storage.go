@@ -0,0 +1,314 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type OrganizationsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewOrganizationsRepository(ctx context.Context, dsn string) (*OrganizationsRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrganizationsRepository{
+		pool: pool,
+	}, nil
+}
+
+// CreateOrganization creates organization with ownerID as its owner.
+func (repo *OrganizationsRepository) CreateOrganization(name string, ownerID int) (*models.Organization, error) {
+	ctx := context.Background()
+	organization := models.Organization{Name: name, Role: models.RoleOwner}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	err = tx.QueryRow(
+		ctx,
+		"insert into organizations (name) values ($1) returning id, created_at",
+		name,
+	).Scan(&organization.ID, &organization.CreatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, NewNotUniqueOrganizationError(name, err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"insert into organization_members (organization_id, user_id, role) values ($1, $2, $3)",
+		organization.ID,
+		ownerID,
+		models.RoleOwner,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization, tx.Commit(ctx)
+}
+
+// ListOrganizations returns organizations that user is a member of with the role of the user.
+func (repo *OrganizationsRepository) ListOrganizations(userID int) ([]models.Organization, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select o.id, o.name, o.created_at, m.role from organizations o
+		join organization_members m on m.organization_id = o.id
+		where m.user_id=$1
+		order by o.name`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var organizations []models.Organization
+	for rows.Next() {
+		var organization models.Organization
+		err = rows.Scan(&organization.ID, &organization.Name, &organization.CreatedAt, &organization.Role)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, organization)
+	}
+
+	return organizations, rows.Err()
+}
+
+// FindRole returns role of the user in organization. Zero role means that user is not a member.
+func (repo *OrganizationsRepository) FindRole(organizationID int64, userID int) (models.OrganizationRole, error) {
+	var role models.OrganizationRole
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"select role from organization_members where organization_id=$1 and user_id=$2",
+		organizationID,
+		userID,
+	).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return 0, nil
+	}
+
+	return role, err
+}
+
+// FindCollectionRole returns role of the user in organization of the collection.
+// Zero role means that user is not a member or collection does not exist.
+func (repo *OrganizationsRepository) FindCollectionRole(collectionID int64, userID int) (models.OrganizationRole, error) {
+	var role models.OrganizationRole
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`select m.role from collections c
+		join organization_members m on m.organization_id = c.organization_id
+		where c.id=$1 and m.user_id=$2`,
+		collectionID,
+		userID,
+	).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return 0, nil
+	}
+
+	return role, err
+}
+
+func (repo *OrganizationsRepository) FindMember(organizationID int64, username string) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`select m.user_id, u.username, m.role, m.created_at from organization_members m
+		join users u on u.id = m.user_id
+		where m.organization_id=$1 and u.username=$2`,
+		organizationID,
+		username,
+	).Scan(&member.UserID, &member.Username, &member.Role, &member.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, NewMemberNotFoundError(organizationID, username, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// ListMembers returns members of organization ordered by username.
+func (repo *OrganizationsRepository) ListMembers(organizationID int64) ([]models.OrganizationMember, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select m.user_id, u.username, m.role, m.created_at from organization_members m
+		join users u on u.id = m.user_id
+		where m.organization_id=$1
+		order by u.username`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []models.OrganizationMember
+	for rows.Next() {
+		var member models.OrganizationMember
+		err = rows.Scan(&member.UserID, &member.Username, &member.Role, &member.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func (repo *OrganizationsRepository) AddMember(organizationID int64, username string, role models.OrganizationRole) error {
+	ctx := context.Background()
+
+	var userID int
+	err := repo.pool.QueryRow(ctx, "select id from users where username=$1", username).Scan(&userID)
+	if err == pgx.ErrNoRows {
+		return NewUserNotFoundError(username, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.pool.Exec(
+		ctx,
+		"insert into organization_members (organization_id, user_id, role) values ($1, $2, $3)",
+		organizationID,
+		userID,
+		role,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return NewNotUniqueMemberError(organizationID, username, err)
+		}
+	}
+	return err
+}
+
+// UpdateMemberRole changes role of the member. It fails with LastOwnerError if organization is left without owners.
+func (repo *OrganizationsRepository) UpdateMemberRole(organizationID int64, userID int, role models.OrganizationRole) error {
+	return repo.changeMembers(
+		organizationID,
+		"update organization_members set role=$3 where organization_id=$1 and user_id=$2",
+		organizationID,
+		userID,
+		role,
+	)
+}
+
+// RemoveMember removes member from organization. It fails with LastOwnerError if organization is left without owners.
+func (repo *OrganizationsRepository) RemoveMember(organizationID int64, userID int) error {
+	return repo.changeMembers(
+		organizationID,
+		"delete from organization_members where organization_id=$1 and user_id=$2",
+		organizationID,
+		userID,
+	)
+}
+
+// changeMembers executes query that changes members of organization and checks that organization still has an owner.
+// Organization is locked until the change is committed, so concurrent changes can't remove all owners.
+func (repo *OrganizationsRepository) changeMembers(organizationID int64, query string, args ...interface{}) error {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(ctx, "select id from organizations where id=$1 for update", organizationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	var hasOwner bool
+	err = tx.QueryRow(
+		ctx,
+		"select exists(select 1 from organization_members where organization_id=$1 and role=$2)",
+		organizationID,
+		models.RoleOwner,
+	).Scan(&hasOwner)
+	if err != nil {
+		return err
+	}
+	if !hasOwner {
+		return NewLastOwnerError(organizationID)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (repo *OrganizationsRepository) CreateCollection(organizationID int64, name string) (*models.Collection, error) {
+	collection := models.Collection{OrganizationID: organizationID, Name: name}
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"insert into collections (organization_id, name) values ($1, $2) returning id, created_at",
+		organizationID,
+		name,
+	).Scan(&collection.ID, &collection.CreatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, NewNotUniqueCollectionError(name, err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// ListCollections returns collections of organization ordered by name.
+func (repo *OrganizationsRepository) ListCollections(organizationID int64) ([]models.Collection, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		"select id, organization_id, name, created_at from collections where organization_id=$1 order by name",
+		organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collections []models.Collection
+	for rows.Next() {
+		var collection models.Collection
+		err = rows.Scan(&collection.ID, &collection.OrganizationID, &collection.Name, &collection.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, collection)
+	}
+
+	return collections, rows.Err()
+}
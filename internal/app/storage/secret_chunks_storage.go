@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+)
+
+// ChunkFunc handles one encrypted chunk of file secret.
+type ChunkFunc func(encryptedChunk []byte) error
+
+// CreateFile saves new file secret. writeFile must pass encrypted chunks of file content
+// to writeChunk in order and return encrypted data of the secret itself.
+// Nothing is saved if writeFile fails.
+func (repo *SecretsRepository) CreateFile(metadata models.SecretMetadata, writeFile func(writeChunk ChunkFunc) ([]byte, error)) error {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if err = lockDataKey(ctx, tx, metadata.DataKeyID); err != nil {
+		return err
+	}
+
+	var secretID int64
+	err = tx.QueryRow(
+		ctx,
+		`insert into secrets (secret_data, user_id, collection_id, secret_type, secret_name, tags, labels, expires_at, rotate_every,
+		                     data_key_id, client_encrypted)
+		values ('', case when $9::bigint = 0 then $1::int end, nullif($9, 0), $2, $3,
+		        coalesce($4::text[], '{}'), coalesce($5::jsonb, '{}'), $6, $7, nullif($8::bigint, 0), $10)
+		returning id`,
+		metadata.UserID,
+		metadata.Type,
+		metadata.Name,
+		tagsArg(metadata.Tags),
+		labelsArg(metadata.Labels),
+		metadata.ExpiresAt,
+		metadata.RotateEvery,
+		metadata.DataKeyID,
+		metadata.CollectionID,
+		metadata.ClientEncrypted,
+	).Scan(&secretID)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return NewNotUniqueSecret(metadata, err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	chunkIndex := 0
+	encryptedData, err := writeFile(func(encryptedChunk []byte) error {
+		_, execErr := tx.Exec(
+			ctx,
+			"insert into secret_chunks (secret_id, chunk_index, chunk_data, data_key_id) values ($1, $2, $3, nullif($4::bigint, 0))",
+			secretID,
+			chunkIndex,
+			encryptedChunk,
+			metadata.DataKeyID,
+		)
+		chunkIndex++
+		return execErr
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "update secrets set secret_data=$1 where id=$2", encryptedData, secretID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReadFileChunks passes encrypted chunks of active file secret with ids of their data keys to readChunk in order.
+// Shared file is read if it is shared with metadata.UserID with any permission.
+func (repo *SecretsRepository) ReadFileChunks(
+	metadata models.SecretMetadata,
+	readChunk func(encryptedChunk []byte, dataKeyID int64) error,
+) error {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select chunk_data, coalesce(data_key_id, 0) from secret_chunks
+		where secret_id = (select id from secrets where `+activeSecretCondition+`)
+		order by chunk_index`,
+		activeSecretArgs(metadata, models.SharePermissionRead)...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chunk []byte
+		var dataKeyID int64
+		err = rows.Scan(&chunk, &dataKeyID)
+		if err != nil {
+			return err
+		}
+
+		err = readChunk(chunk, dataKeyID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
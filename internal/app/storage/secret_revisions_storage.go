@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4"
+)
+
+// ListRevisions returns revisions of active secret, newest first.
+// Revisions of shared secret are listed if it is shared with metadata.UserID with any permission.
+func (repo *SecretsRepository) ListRevisions(metadata models.SecretMetadata) ([]models.SecretRevision, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select r.secret_id, r.version, r.created_at from secret_revisions r
+		join secrets on secrets.id = r.secret_id
+		where `+activeSecretCondition+`
+		order by r.version desc`,
+		activeSecretArgs(metadata, models.SharePermissionRead)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []models.SecretRevision
+	for rows.Next() {
+		var revision models.SecretRevision
+		err = rows.Scan(&revision.SecretID, &revision.Version, &revision.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, rows.Err()
+}
+
+// FindRevisionData returns encrypted data of revision of active secret.
+// Revision of shared secret is found if it is shared with metadata.UserID with any permission.
+func (repo *SecretsRepository) FindRevisionData(metadata models.SecretMetadata, version int64) ([]byte, models.SecretRevision, error) {
+	var data []byte
+	var revision models.SecretRevision
+
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`select r.secret_data, r.secret_id, r.version, r.created_at, r.client_encrypted, coalesce(r.data_key_id, 0)
+		from secret_revisions r
+		join secrets on secrets.id = r.secret_id
+		where `+activeSecretCondition+` and r.version=$7`,
+		append(activeSecretArgs(metadata, models.SharePermissionRead), version)...,
+	).Scan(&data, &revision.SecretID, &revision.Version, &revision.CreatedAt, &revision.ClientEncrypted, &revision.DataKeyID)
+
+	if err == pgx.ErrNoRows {
+		return nil, revision, NewRevisionNotFoundError(metadata, version, err)
+	}
+
+	return data, revision, err
+}
+
+// RestoreRevision replaces data of active secret with data of its revision.
+// Current data is kept as a new revision. It returns the new version of the secret.
+func (repo *SecretsRepository) RestoreRevision(metadata models.SecretMetadata, version int64) (int64, error) {
+	return repo.replaceSecretData(metadata, func(tx pgx.Tx, current models.SecretMetadata) (secretData, error) {
+		var data secretData
+		err := tx.QueryRow(
+			context.Background(),
+			`select secret_data, coalesce(data_key_id, 0), client_encrypted from secret_revisions
+			where secret_id=$1 and version=$2`,
+			current.ID,
+			version,
+		).Scan(&data.data, &data.dataKeyID, &data.clientEncrypted)
+		if err == pgx.ErrNoRows {
+			return data, NewRevisionNotFoundError(metadata, version, err)
+		}
+
+		return data, err
+	})
+}
+
+// secretData is encrypted data of secret with the way it is encrypted.
+type secretData struct {
+	data            []byte
+	dataKeyID       int64
+	clientEncrypted bool
+}
+
+// replaceSecretData saves current data of active secret as a revision and replaces it with data
+// returned by newData. Oldest revisions over repo.maxRevisions are removed.
+// Shared secret is replaced only if it is shared with metadata.UserID with write permission.
+func (repo *SecretsRepository) replaceSecretData(
+	metadata models.SecretMetadata,
+	newData func(tx pgx.Tx, current models.SecretMetadata) (secretData, error),
+) (int64, error) {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var current models.SecretMetadata
+	err = tx.QueryRow(
+		ctx,
+		"select "+secretMetadataColumns+" from secrets where "+activeSecretCondition+" for update",
+		activeSecretArgs(metadata, models.SharePermissionWrite)...,
+	).Scan(secretMetadataFields(&current)...)
+	if err == pgx.ErrNoRows {
+		return 0, NewSecretNotFoundError(metadata, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := newData(tx, current)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = lockDataKey(ctx, tx, data.dataKeyID); err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`insert into secret_revisions (secret_id, version, secret_data, created_at, client_encrypted, data_key_id)
+		select id, version, secret_data, updated_at, client_encrypted, data_key_id from secrets where id=$1`,
+		current.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	err = tx.QueryRow(
+		ctx,
+		`update secrets
+		set secret_data=$1, version=version+1, updated_at=now(), client_encrypted=$7, data_key_id=nullif($8::bigint, 0),
+		    tags=coalesce($3::text[], tags), labels=coalesce($4::jsonb, labels),
+		    expires_at=case when $9::boolean then $5 else expires_at end,
+		    rotate_every=case when $10::boolean then $6 else rotate_every end
+		where id=$2
+		returning version`,
+		data.data,
+		current.ID,
+		tagsArg(metadata.Tags),
+		labelsArg(metadata.Labels),
+		metadata.ExpiresAt,
+		metadata.RotateEvery,
+		data.clientEncrypted,
+		data.dataKeyID,
+		metadata.ExpiresAtSet,
+		metadata.RotateEverySet,
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"delete from secret_revisions where secret_id=$1 and version < $2",
+		current.ID,
+		version-int64(repo.maxRevisions),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return version, tx.Commit(ctx)
+}
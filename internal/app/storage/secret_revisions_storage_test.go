@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+)
+
+func TestUpdateKeepsExpiryAndRotationNotSetInMetadata(t *testing.T) {
+	dsn := testDSN(t)
+	repo, err := NewSecretsRepository(context.Background(), dsn, 10)
+	if err != nil {
+		t.Fatalf("cant init secrets repo: %v", err)
+	}
+
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	metadata := models.SecretMetadata{
+		Name:        "expiring",
+		Type:        models.SecretTypeText,
+		UserID:      testUser(t, dsn),
+		Tags:        []string{"old"},
+		ExpiresAt:   &expiresAt,
+		RotateEvery: 30 * 24 * time.Hour,
+	}
+	if err = repo.CreateNew([]byte("data"), metadata); err != nil {
+		t.Fatalf("cant create secret: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		update          models.SecretMetadata
+		wantExpiresAt   *time.Time
+		wantRotateEvery time.Duration
+	}{
+		{
+			name:            "tags only",
+			update:          models.SecretMetadata{Tags: []string{"new"}},
+			wantExpiresAt:   &expiresAt,
+			wantRotateEvery: 30 * 24 * time.Hour,
+		},
+		{
+			name:            "rotation only",
+			update:          models.SecretMetadata{RotateEvery: 7 * 24 * time.Hour, RotateEverySet: true},
+			wantExpiresAt:   &expiresAt,
+			wantRotateEvery: 7 * 24 * time.Hour,
+		},
+		{
+			name:            "clear expiry",
+			update:          models.SecretMetadata{ExpiresAtSet: true},
+			wantExpiresAt:   nil,
+			wantRotateEvery: 7 * 24 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, current, err := repo.FindSecretData(metadata)
+			if err != nil {
+				t.Fatalf("cant find secret: %v", err)
+			}
+
+			update := tt.update
+			update.Name, update.Type, update.UserID, update.Version = metadata.Name, metadata.Type, metadata.UserID, current.Version
+			if _, err = repo.Update([]byte("data"), update); err != nil {
+				t.Fatalf("cant update secret: %v", err)
+			}
+
+			_, updated, err := repo.FindSecretData(metadata)
+			if err != nil {
+				t.Fatalf("cant find secret: %v", err)
+			}
+			if (updated.ExpiresAt == nil) != (tt.wantExpiresAt == nil) ||
+				updated.ExpiresAt != nil && !updated.ExpiresAt.Equal(*tt.wantExpiresAt) {
+				t.Errorf("expires at = %v, want %v", updated.ExpiresAt, tt.wantExpiresAt)
+			}
+			if updated.RotateEvery != tt.wantRotateEvery {
+				t.Errorf("rotate every = %v, want %v", updated.RotateEvery, tt.wantRotateEvery)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4"
+)
+
+// ShareSecret gives user with username access to own active secret of metadata.UserID.
+// If secret is already shared with the user, permission of the share is replaced.
+func (repo *SecretsRepository) ShareSecret(metadata models.SecretMetadata, username string, permission models.SharePermission) error {
+	ctx := context.Background()
+
+	var secretID int64
+	err := repo.pool.QueryRow(
+		ctx,
+		"select id from secrets where secret_type=$1 and user_id=$2 and secret_name=$3 and deleted_at is null",
+		metadata.Type,
+		metadata.UserID,
+		metadata.Name,
+	).Scan(&secretID)
+	if err == pgx.ErrNoRows {
+		return NewSecretNotFoundError(metadata, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var userID int
+	err = repo.pool.QueryRow(ctx, "select id from users where username=$1", username).Scan(&userID)
+	if err == pgx.ErrNoRows {
+		return NewUserNotFoundError(username, err)
+	}
+	if err != nil {
+		return err
+	}
+	if userID == metadata.UserID {
+		return NewShareWithOwnerError(username)
+	}
+
+	_, err = repo.pool.Exec(
+		ctx,
+		`insert into secret_shares (secret_id, user_id, permission) values ($1, $2, $3)
+		on conflict (secret_id, user_id) do update set permission=excluded.permission`,
+		secretID,
+		userID,
+		permission,
+	)
+
+	return err
+}
+
+// RevokeShare removes access of user with username to own active secret of metadata.UserID.
+func (repo *SecretsRepository) RevokeShare(metadata models.SecretMetadata, username string) error {
+	tag, err := repo.pool.Exec(
+		context.Background(),
+		`delete from secret_shares sh using secrets s, users u
+		where sh.secret_id = s.id and sh.user_id = u.id
+		  and s.secret_type=$1 and s.user_id=$2 and s.secret_name=$3 and s.deleted_at is null and u.username=$4`,
+		metadata.Type,
+		metadata.UserID,
+		metadata.Name,
+		username,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewShareNotFoundError(metadata, username, pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ListShares returns users that have access to own active secret of metadata.UserID, ordered by username.
+func (repo *SecretsRepository) ListShares(metadata models.SecretMetadata) ([]models.SecretShare, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select sh.secret_id, sh.user_id, u.username, sh.permission, sh.created_at from secret_shares sh
+		join secrets s on s.id = sh.secret_id
+		join users u on u.id = sh.user_id
+		where s.secret_type=$1 and s.user_id=$2 and s.secret_name=$3 and s.deleted_at is null
+		order by u.username`,
+		metadata.Type,
+		metadata.UserID,
+		metadata.Name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []models.SecretShare
+	for rows.Next() {
+		var share models.SecretShare
+		err = rows.Scan(&share.SecretID, &share.UserID, &share.Username, &share.Permission, &share.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, rows.Err()
+}
+
+// FindSharePermission returns permission of metadata.UserID to active secret with metadata.ID that is shared with them.
+func (repo *SecretsRepository) FindSharePermission(metadata models.SecretMetadata) (models.SharePermission, error) {
+	var permission models.SharePermission
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`select sh.permission from secret_shares sh
+		join secrets on secrets.id = sh.secret_id
+		where sh.secret_id=$1 and sh.user_id=$2 and secrets.secret_type=$3 and secrets.deleted_at is null`,
+		metadata.ID,
+		metadata.UserID,
+		metadata.Type,
+	).Scan(&permission)
+	if err == pgx.ErrNoRows {
+		return 0, NewSecretNotFoundError(metadata, err)
+	}
+
+	return permission, err
+}
+
+// ListSharedWithMe returns active secrets of other users that are shared with the user, ordered by name.
+func (repo *SecretsRepository) ListSharedWithMe(userID int) ([]models.SharedSecret, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select owners.username, shares.permission, `+secretMetadataColumns+` from secrets
+		join (select secret_id, permission from secret_shares where user_id=$1) shares on shares.secret_id = secrets.id
+		join (select id as owner_id, username from users) owners on owners.owner_id = secrets.user_id
+		where deleted_at is null
+		order by secret_name, secret_type`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var secrets []models.SharedSecret
+	for rows.Next() {
+		var secret models.SharedSecret
+		err = rows.Scan(append([]interface{}{&secret.OwnerName, &secret.Permission}, secretMetadataFields(&secret.Metadata)...)...)
+		if err != nil {
+			return nil, err
+		}
+		secret.Metadata.Shared = true
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
@@ -3,6 +3,8 @@ package storage
 import (
 	"context"
 	"errors"
+	"time"
+
 	"github.com/jackc/pgx/v4"
 
 	"github.com/belamov/ypgo-password-manager/internal/app/models"
@@ -13,37 +15,54 @@ import (
 )
 
 type SecretsRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	maxRevisions int
 }
 
-func NewSecretsRepository(ctx context.Context, dsn string) (*SecretsRepository, error) {
+// NewSecretsRepository creates repository that keeps at most maxRevisions previous versions of every secret.
+func NewSecretsRepository(ctx context.Context, dsn string, maxRevisions int) (*SecretsRepository, error) {
 	pool, err := pgxpool.Connect(ctx, dsn)
 	if err != nil {
 		return nil, err
 	}
 
 	return &SecretsRepository{
-		pool: pool,
+		pool:         pool,
+		maxRevisions: maxRevisions,
 	}, nil
 }
 
 func (repo *SecretsRepository) CreateNew(encryptedData []byte, metadata models.SecretMetadata) error {
-	conn, err := repo.pool.Acquire(context.Background())
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("couldnt acquire connection from pool")
 		return err
 	}
+	defer tx.Rollback(ctx) //nolint:errcheck
 
-	err = conn.QueryRow(
-		context.Background(),
-		"insert into secrets (secret_data, user_id, secret_type, secret_name) values ($1, $2, $3, $4)",
+	if err = lockDataKey(ctx, tx, metadata.DataKeyID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`insert into secrets (secret_data, user_id, collection_id, secret_type, secret_name, tags, labels, expires_at, rotate_every,
+		                     client_encrypted, data_key_id)
+		values ($1, case when $9::bigint = 0 then $2::int end, nullif($9, 0), $3, $4,
+		        coalesce($5::text[], '{}'), coalesce($6::jsonb, '{}'), $7, $8, $10, nullif($11::bigint, 0))`,
 		encryptedData,
 		metadata.UserID,
 		metadata.Type,
 		metadata.Name,
-	).Scan()
-
-	conn.Release()
+		tagsArg(metadata.Tags),
+		labelsArg(metadata.Labels),
+		metadata.ExpiresAt,
+		metadata.RotateEvery,
+		metadata.CollectionID,
+		metadata.ClientEncrypted,
+		metadata.DataKeyID,
+	)
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -51,31 +70,399 @@ func (repo *SecretsRepository) CreateNew(encryptedData []byte, metadata models.S
 			return NewNotUniqueSecret(metadata, err)
 		}
 	}
-	return nil
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-func (repo *SecretsRepository) FindSecretData(metadata models.SecretMetadata) ([]byte, error) {
+// FindSecretData returns encrypted data of active secret and its full metadata.
+// Shared secret is found if it is shared with metadata.UserID with any permission.
+func (repo *SecretsRepository) FindSecretData(metadata models.SecretMetadata) ([]byte, models.SecretMetadata, error) {
 	var data []byte
+	var found models.SecretMetadata
 
 	conn, err := repo.pool.Acquire(context.Background())
 	if err != nil {
 		log.Error().Err(err).Msg("couldnt acquire connection from pool")
-		return nil, err
+		return nil, found, err
 	}
 
 	err = conn.QueryRow(
 		context.Background(),
-		"select secret_data from secrets where secret_type=$1 and user_id=$2 and secret_name=$3",
+		"select secret_data, "+secretMetadataColumns+" from secrets where "+activeSecretCondition,
+		activeSecretArgs(metadata, models.SharePermissionRead)...,
+	).Scan(append([]interface{}{&data}, secretMetadataFields(&found)...)...)
+
+	conn.Release()
+
+	if err == pgx.ErrNoRows {
+		return nil, found, NewSecretNotFoundError(metadata, err)
+	}
+
+	return data, found, err
+}
+
+// Update replaces encrypted data of active secret if its version still equals metadata.Version.
+// Previous data is kept as a revision. It returns the new version of the secret.
+func (repo *SecretsRepository) Update(encryptedData []byte, metadata models.SecretMetadata) (int64, error) {
+	return repo.replaceSecretData(metadata, func(_ pgx.Tx, current models.SecretMetadata) (secretData, error) {
+		if current.Version != metadata.Version {
+			return secretData{}, NewSecretVersionConflictError(metadata, current.Version)
+		}
+		return secretData{
+			data:            encryptedData,
+			dataKeyID:       metadata.DataKeyID,
+			clientEncrypted: metadata.ClientEncrypted,
+		}, nil
+	})
+}
+
+func (repo *SecretsRepository) ListSecrets(filter models.SecretsFilter) ([]models.SecretMetadata, error) {
+	conn, err := repo.pool.Acquire(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("couldnt acquire connection from pool")
+		return nil, err
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(
+		context.Background(),
+		`select `+secretMetadataColumns+` from secrets
+		where ($9::bigint = 0 and user_id=$1 or collection_id=$9)
+		  and id > $2
+		  and ($3 = 0 or secret_type=$3)
+		  and ($4 = '' or starts_with(secret_name, $4))
+		  and (deleted_at is not null) = $6
+		  and ($7::text[] is null or tags @> $7)
+		  and ($8::jsonb is null or labels @> $8)
+		order by id
+		limit $5`,
+		filter.UserID,
+		filter.Cursor,
+		filter.Type,
+		filter.NamePrefix,
+		filter.Limit,
+		filter.Deleted,
+		tagsArg(filter.Tags),
+		labelsArg(filter.Labels),
+		filter.CollectionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	secrets := make([]models.SecretMetadata, 0, filter.Limit)
+	for rows.Next() {
+		var metadata models.SecretMetadata
+		metadata, err = scanSecretMetadata(rows)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, metadata)
+	}
+
+	return secrets, rows.Err()
+}
+
+// Delete moves active secret to the trash.
+func (repo *SecretsRepository) Delete(metadata models.SecretMetadata) error {
+	return repo.execOnSecret(
+		metadata,
+		`update secrets set deleted_at=now()
+		where secret_type=$1 and ($4::bigint = 0 and user_id=$2 or collection_id=$4) and secret_name=$3 and deleted_at is null`,
 		metadata.Type,
 		metadata.UserID,
 		metadata.Name,
-	).Scan(&data)
+		metadata.CollectionID,
+	)
+}
 
-	conn.Release()
+// Restore moves secret with metadata.ID from the trash back to active secrets.
+// It fails with NotUniqueSecretError if active secret with the same name already exists.
+func (repo *SecretsRepository) Restore(metadata models.SecretMetadata) error {
+	err := repo.execOnSecret(
+		metadata,
+		"update secrets set deleted_at=null where id=$1 and ($3::bigint = 0 and user_id=$2 or collection_id=$3) and deleted_at is not null",
+		metadata.ID,
+		metadata.UserID,
+		metadata.CollectionID,
+	)
 
-	if err == pgx.ErrNoRows {
-		return nil, NewSecretNotFoundError(metadata, err)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return NewNotUniqueSecret(metadata, err)
+		}
+	}
+	return err
+}
+
+// Purge permanently removes secret with metadata.ID from the trash.
+func (repo *SecretsRepository) Purge(metadata models.SecretMetadata) error {
+	return repo.execOnSecret(
+		metadata,
+		"delete from secrets where id=$1 and ($3::bigint = 0 and user_id=$2 or collection_id=$3) and deleted_at is not null",
+		metadata.ID,
+		metadata.UserID,
+		metadata.CollectionID,
+	)
+}
+
+// PurgeDeletedBefore permanently removes all secrets that were moved to the trash before deletedBefore.
+func (repo *SecretsRepository) PurgeDeletedBefore(deletedBefore time.Time) (int64, error) {
+	tag, err := repo.pool.Exec(
+		context.Background(),
+		"delete from secrets where deleted_at < $1",
+		deletedBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// ListDue returns active secrets that expire or need rotation before dueBefore, ordered by due date.
+// Rotation is due RotateEvery after the last update of the secret. Non-zero collectionID lists secrets
+// of the collection, otherwise personal secrets of userID are listed, zero userID lists secrets of all users.
+func (repo *SecretsRepository) ListDue(userID int, collectionID int64, dueBefore time.Time) ([]models.DueSecret, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		`select * from (
+			select `+secretMetadataColumns+`, expires_at as due_at, $3::varchar as reason from secrets
+			where expires_at is not null
+			union all
+			select `+secretMetadataColumns+`, updated_at + rotate_every as due_at, $4::varchar as reason from secrets
+			where rotate_every > interval '0'
+		) due
+		where ($5::bigint = 0 and collection_id = 0 and ($1 = 0 or user_id=$1) or collection_id = $5)
+		  and deleted_at is null and due_at < $2
+		order by due_at, id`,
+		userID,
+		dueBefore,
+		string(models.DueReasonExpiry),
+		string(models.DueReasonRotation),
+		collectionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var secrets []models.DueSecret
+	for rows.Next() {
+		var secret models.DueSecret
+		err = rows.Scan(append(secretMetadataFields(&secret.Metadata), &secret.DueAt, &secret.Reason)...)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
+
+// execOnSecret executes query that must affect exactly one secret.
+func (repo *SecretsRepository) execOnSecret(metadata models.SecretMetadata, query string, args ...interface{}) error {
+	tag, err := repo.pool.Exec(context.Background(), query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewSecretNotFoundError(metadata, pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+// activeSecretCondition selects active secret by arguments from activeSecretArgs.
+// Own secret is selected by user or collection, type and name and shared one by id, type and permission of its share.
+const activeSecretCondition = `secret_type=$1 and deleted_at is null and (
+	($4::bigint = 0 and ($6::bigint = 0 and user_id=$2 or collection_id=$6) and secret_name=$3) or
+	(id=$4 and exists(
+		select 1 from secret_shares sh where sh.secret_id=secrets.id and sh.user_id=$2 and sh.permission >= $5
+	))
+)`
+
+// activeSecretArgs returns arguments of activeSecretCondition. Shared secret
+// is selected only if it is shared with metadata.UserID with at least given permission.
+func activeSecretArgs(metadata models.SecretMetadata, permission models.SharePermission) []interface{} {
+	var sharedID int64
+	if metadata.Shared {
+		sharedID = metadata.ID
+	}
+
+	return []interface{}{metadata.Type, metadata.UserID, metadata.Name, sharedID, permission, metadata.CollectionID}
+}
+
+// secretMetadataColumns are columns of SecretMetadata. Secrets of collections have zero user_id.
+const secretMetadataColumns = "id, secret_name, secret_type, coalesce(user_id, 0) as user_id, version, tags, labels, " +
+	"created_at, updated_at, deleted_at, expires_at, rotate_every, coalesce(collection_id, 0) as collection_id, client_encrypted, " +
+	"coalesce(data_key_id, 0) as data_key_id"
+
+// secretMetadataFields returns scan destinations matching secretMetadataColumns.
+func secretMetadataFields(metadata *models.SecretMetadata) []interface{} {
+	return []interface{}{
+		&metadata.ID,
+		&metadata.Name,
+		&metadata.Type,
+		&metadata.UserID,
+		&metadata.Version,
+		&metadata.Tags,
+		&metadata.Labels,
+		&metadata.CreatedAt,
+		&metadata.UpdatedAt,
+		&metadata.DeletedAt,
+		&metadata.ExpiresAt,
+		&metadata.RotateEvery,
+		&metadata.CollectionID,
+		&metadata.ClientEncrypted,
+		&metadata.DataKeyID,
+	}
+}
+
+func scanSecretMetadata(row pgx.Row) (models.SecretMetadata, error) {
+	var metadata models.SecretMetadata
+	err := row.Scan(secretMetadataFields(&metadata)...)
+
+	return metadata, err
+}
+
+// tagsArg converts tags to query argument. Nil tags are passed as NULL.
+func tagsArg(tags []string) interface{} {
+	if tags == nil {
+		return nil
+	}
+	return tags
+}
+
+// labelsArg converts labels to query argument. Nil labels are passed as NULL.
+func labelsArg(labels map[string]string) interface{} {
+	if labels == nil {
+		return nil
+	}
+	return labels
+}
+
+// ReencryptFunc decrypts data with data key dataKeyID, zero is the master key of the server,
+// and encrypts it with the current data key of the vault. Vault is set by UserID and CollectionID of the metadata.
+// It returns new data and id of the data key.
+type ReencryptFunc func(vault models.SecretMetadata, dataKeyID int64, encryptedData []byte) ([]byte, int64, error)
+
+// retiredDataQueries select rows that are encrypted with the master key of the server or with retired data keys,
+// update them and mark rows that can't be reencrypted. Rows are identified by two numbers.
+var retiredDataQueries = []struct { //nolint:gochecknoglobals
+	selectRows string
+	updateRow  string
+	markRow    string
+}{
+	{
+		selectRows: `select id, version, coalesce(user_id, 0), coalesce(collection_id, 0), coalesce(data_key_id, 0), secret_data
+			from secrets
+			where (data_key_id is null or data_key_id in (select id from data_keys where retired_at is not null))
+			  and not client_encrypted and not reencryption_failed
+			limit $1 for update skip locked`,
+		updateRow: "update secrets set secret_data=$1, data_key_id=$2 where id=$3 and version=$4",
+		markRow:   "update secrets set reencryption_failed=true where id=$1 and version=$2",
+	},
+	{
+		selectRows: `select r.secret_id, r.version, coalesce(s.user_id, 0), coalesce(s.collection_id, 0), coalesce(r.data_key_id, 0), r.secret_data
+			from secret_revisions r join secrets s on s.id = r.secret_id
+			where (r.data_key_id is null or r.data_key_id in (select id from data_keys where retired_at is not null))
+			  and not r.client_encrypted and not r.reencryption_failed
+			limit $1 for update of r skip locked`,
+		updateRow: "update secret_revisions set secret_data=$1, data_key_id=$2 where secret_id=$3 and version=$4",
+		markRow:   "update secret_revisions set reencryption_failed=true where secret_id=$1 and version=$2",
+	},
+	{
+		selectRows: `select c.secret_id, c.chunk_index, coalesce(s.user_id, 0), coalesce(s.collection_id, 0), coalesce(c.data_key_id, 0), c.chunk_data
+			from secret_chunks c join secrets s on s.id = c.secret_id
+			where (c.data_key_id is null or c.data_key_id in (select id from data_keys where retired_at is not null))
+			  and not s.client_encrypted and not c.reencryption_failed
+			limit $1 for update of c skip locked`,
+		updateRow: "update secret_chunks set chunk_data=$1, data_key_id=$2 where secret_id=$3 and chunk_index=$4",
+		markRow:   "update secret_chunks set reencryption_failed=true where secret_id=$1 and chunk_index=$2",
+	},
+}
+
+// ReencryptRetiredData passes data of secrets, revisions and file chunks that is encrypted with the master key
+// of the server or with retired data keys to reencrypt and saves the result. Up to limit rows of every table
+// are reencrypted at once. Secrets that are encrypted by the client are skipped. Rows that reencrypt fails on
+// are marked and are not passed again, so one broken row doesn't stop the rest. It returns the number of processed rows.
+func (repo *SecretsRepository) ReencryptRetiredData(limit int, reencrypt ReencryptFunc) (int64, error) {
+	ctx := context.Background()
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var reencrypted int64
+	for _, queries := range retiredDataQueries {
+		count, err := reencryptRows(ctx, tx, queries.selectRows, queries.updateRow, queries.markRow, limit, reencrypt)
+		if err != nil {
+			return 0, err
+		}
+		reencrypted += count
+	}
+
+	return reencrypted, tx.Commit(ctx)
+}
+
+func reencryptRows(ctx context.Context, tx pgx.Tx, selectRows string, updateRow string, markRow string, limit int, reencrypt ReencryptFunc) (int64, error) {
+	type retiredRow struct {
+		data      []byte
+		vault     models.SecretMetadata
+		id        int64
+		index     int64
+		dataKeyID int64
+	}
+
+	rows, err := tx.Query(ctx, selectRows, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var retiredRows []retiredRow
+	for rows.Next() {
+		var row retiredRow
+		err = rows.Scan(&row.id, &row.index, &row.vault.UserID, &row.vault.CollectionID, &row.dataKeyID, &row.data)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		retiredRows = append(retiredRows, row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, row := range retiredRows {
+		data, dataKeyID, err := reencrypt(row.vault, row.dataKeyID, row.data)
+		if err != nil {
+			log.Error().Err(err).Int64("id", row.id).Int64("index", row.index).Msg("cant reencrypt row, marking it as failed")
+			_, err = tx.Exec(ctx, markRow, row.id, row.index)
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err = lockDataKey(ctx, tx, dataKeyID); err != nil {
+			return 0, err
+		}
+
+		_, err = tx.Exec(ctx, updateRow, data, dataKeyID, row.id, row.index)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	return data, err
+	return int64(len(retiredRows)), nil
 }
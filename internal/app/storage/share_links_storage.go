@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgx/v4"
+)
+
+// CreateShareLink saves snapshot of the secret encrypted with the key of the link.
+// Link is found by hash of its token, so the token itself is never stored.
+func (repo *SecretsRepository) CreateShareLink(link models.ShareLink, tokenHash []byte, encryptedData []byte) (models.ShareLink, error) {
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`insert into share_links (token_hash, user_id, secret_type, secret_name, secret_data, max_redemptions, expires_at)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		returning id, created_at`,
+		tokenHash,
+		link.UserID,
+		link.SecretType,
+		link.SecretName,
+		encryptedData,
+		link.MaxRedemptions,
+		link.ExpiresAt,
+	).Scan(&link.ID, &link.CreatedAt)
+
+	return link, err
+}
+
+// RedeemShareLink counts redemption of the link and returns its encrypted snapshot.
+// Expired and fully redeemed links are not found.
+func (repo *SecretsRepository) RedeemShareLink(tokenHash []byte) ([]byte, models.ShareLink, error) {
+	var data []byte
+	var link models.ShareLink
+
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`update share_links set redemptions = redemptions + 1
+		where token_hash=$1 and expires_at > now() and redemptions < max_redemptions
+		returning secret_data, id, user_id, secret_type, secret_name, max_redemptions, redemptions, expires_at, created_at`,
+		tokenHash,
+	).Scan(
+		&data,
+		&link.ID,
+		&link.UserID,
+		&link.SecretType,
+		&link.SecretName,
+		&link.MaxRedemptions,
+		&link.Redemptions,
+		&link.ExpiresAt,
+		&link.CreatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, link, NewShareLinkNotFoundError(err)
+	}
+
+	return data, link, err
+}
+
+// PurgeShareLinks removes share links that expired or were fully redeemed.
+func (repo *SecretsRepository) PurgeShareLinks() (int64, error) {
+	tag, err := repo.pool.Exec(
+		context.Background(),
+		"delete from share_links where expires_at <= now() or redemptions >= max_redemptions",
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"errors"
 	"os"
+	"time"
 
 	"github.com/belamov/ypgo-password-manager/internal/app/models"
 	"github.com/golang-migrate/migrate/v4"
@@ -14,11 +15,97 @@ import (
 type UserStorage interface {
 	CreateNew(username string, hashedPassword string) (*models.User, error)
 	Find(username string) (*models.User, error)
+	GetMinPasswordScore(userID int) (int, error)
+	SetMinPasswordScore(userID int, score int) error
+	GetKDFParams(userID int) (models.KDFParams, error)
+	SetKDFParams(userID int, params models.KDFParams) (models.KDFParams, error)
+	SetKDFKeyCheck(userID int, keyCheck []byte) (models.KDFParams, error)
 }
 
 type SecretsStorage interface {
 	CreateNew(encryptedData []byte, metadata models.SecretMetadata) error
-	FindSecretData(metadata models.SecretMetadata) ([]byte, error)
+	FindSecretData(metadata models.SecretMetadata) ([]byte, models.SecretMetadata, error)
+	Update(encryptedData []byte, metadata models.SecretMetadata) (int64, error)
+	ListSecrets(filter models.SecretsFilter) ([]models.SecretMetadata, error)
+	Delete(metadata models.SecretMetadata) error
+	Restore(metadata models.SecretMetadata) error
+	Purge(metadata models.SecretMetadata) error
+	PurgeDeletedBefore(deletedBefore time.Time) (int64, error)
+	ListRevisions(metadata models.SecretMetadata) ([]models.SecretRevision, error)
+	FindRevisionData(metadata models.SecretMetadata, version int64) ([]byte, models.SecretRevision, error)
+	RestoreRevision(metadata models.SecretMetadata, version int64) (int64, error)
+	CreateFile(metadata models.SecretMetadata, writeFile func(writeChunk ChunkFunc) ([]byte, error)) error
+	ReadFileChunks(metadata models.SecretMetadata, readChunk func(encryptedChunk []byte, dataKeyID int64) error) error
+	ListDue(userID int, collectionID int64, dueBefore time.Time) ([]models.DueSecret, error)
+	ShareSecret(metadata models.SecretMetadata, username string, permission models.SharePermission) error
+	RevokeShare(metadata models.SecretMetadata, username string) error
+	ListShares(metadata models.SecretMetadata) ([]models.SecretShare, error)
+	ListSharedWithMe(userID int) ([]models.SharedSecret, error)
+	FindSharePermission(metadata models.SecretMetadata) (models.SharePermission, error)
+	CreateShareLink(link models.ShareLink, tokenHash []byte, encryptedData []byte) (models.ShareLink, error)
+	RedeemShareLink(tokenHash []byte) ([]byte, models.ShareLink, error)
+	PurgeShareLinks() (int64, error)
+	ReencryptRetiredData(limit int, reencrypt ReencryptFunc) (int64, error)
+}
+
+type NotificationStorage interface {
+	CreateNotifications(notifications []models.Notification) (int64, error)
+	TakeUnreadNotifications(userID int) ([]models.Notification, error)
+}
+
+type FolderStorage interface {
+	CreateFolder(userID int, collectionID int64, path string) error
+	MoveFolder(userID int, collectionID int64, from string, to string) error
+	DeleteFolder(userID int, collectionID int64, path string) error
+	ListFolder(userID int, collectionID int64, path string) (models.FolderContents, error)
+}
+
+type OrganizationStorage interface {
+	CreateOrganization(name string, ownerID int) (*models.Organization, error)
+	ListOrganizations(userID int) ([]models.Organization, error)
+	FindRole(organizationID int64, userID int) (models.OrganizationRole, error)
+	FindCollectionRole(collectionID int64, userID int) (models.OrganizationRole, error)
+	FindMember(organizationID int64, username string) (*models.OrganizationMember, error)
+	ListMembers(organizationID int64) ([]models.OrganizationMember, error)
+	AddMember(organizationID int64, username string, role models.OrganizationRole) error
+	UpdateMemberRole(organizationID int64, userID int, role models.OrganizationRole) error
+	RemoveMember(organizationID int64, userID int) error
+	CreateCollection(organizationID int64, name string) (*models.Collection, error)
+	ListCollections(organizationID int64) ([]models.Collection, error)
+}
+
+type EmergencyAccessStorage interface {
+	NominateContact(ownerID int, username string, waitPeriod time.Duration) error
+	FindContact(ownerID int, username string) (*models.EmergencyContact, error)
+	FindGrantor(contactID int, ownerName string) (*models.EmergencyContact, error)
+	FindEmergencyAccess(ownerID int, contactID int) (*models.EmergencyContact, error)
+	UpdateContactStatus(ownerID int, contactID int, status models.EmergencyAccessStatus) error
+	RemoveContact(ownerID int, contactID int) error
+	ListContacts(ownerID int) ([]models.EmergencyContact, error)
+	ListGrantors(contactID int) ([]models.EmergencyContact, error)
+}
+
+type DataKeysStorage interface {
+	CreateVaultKey(key models.DataKey) (models.DataKey, error)
+	FindVaultKey(userID int, collectionID int64) (models.DataKey, error)
+	FindDataKey(id int64) (models.DataKey, error)
+	RetireVaultKey(userID int, collectionID int64) error
+	DeleteVaultKeys(userID int, collectionID int64) error
+	DeleteUnusedRetiredKeys() (int64, error)
+	RewrapDataKeys(rewrap func(key models.DataKey) ([]byte, error)) (int64, error)
+}
+
+type AuditStorage interface {
+	AddEvent(event models.AuditEvent) error
+	ListEvents(filter models.AuditFilter) ([]models.AuditEvent, error)
+}
+
+type TemplateStorage interface {
+	CreateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error)
+	UpdateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error)
+	FindTemplate(userID int, templateID int64) (*models.SecretTemplate, error)
+	ListTemplates(userID int) ([]models.SecretTemplate, error)
+	DeleteTemplate(userID int, templateID int64) error
 }
 
 func RunMigrations(dsn string) error {
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// testDSN returns dsn of the test database from TEST_DSN, tests that need database are skipped without it.
+// Migrations are applied to the database.
+func testDSN(t *testing.T) string {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DSN is not set")
+	}
+
+	if os.Getenv("MIGRATIONS_PATH") == "" {
+		t.Setenv("MIGRATIONS_PATH", "file://migrations")
+	}
+	if err := RunMigrations(dsn); err != nil {
+		t.Fatalf("cant run migrations: %v", err)
+	}
+
+	return dsn
+}
+
+// testUser creates user with unique name and returns its id.
+func testUser(t *testing.T, dsn string) int {
+	t.Helper()
+
+	users, err := NewUserRepository(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("cant init user repo: %v", err)
+	}
+
+	user, err := users.CreateNew(fmt.Sprintf("test-%d", time.Now().UnixNano()), "hash")
+	if err != nil {
+		t.Fatalf("cant create user: %v", err)
+	}
+
+	return user.Id
+}
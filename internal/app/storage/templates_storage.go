@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/belamov/ypgo-password-manager/internal/app/models"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type TemplatesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTemplatesRepository(ctx context.Context, dsn string) (*TemplatesRepository, error) {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplatesRepository{
+		pool: pool,
+	}, nil
+}
+
+func (repo *TemplatesRepository) CreateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error) {
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"insert into secret_templates (user_id, name, fields) values ($1, $2, $3) returning id, created_at, updated_at",
+		template.UserID,
+		template.Name,
+		template.Fields,
+	).Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, NewNotUniqueTemplateError(template.Name, err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (repo *TemplatesRepository) UpdateTemplate(template models.SecretTemplate) (*models.SecretTemplate, error) {
+	err := repo.pool.QueryRow(
+		context.Background(),
+		`update secret_templates set name=$1, fields=$2, updated_at=now()
+		where id=$3 and user_id=$4
+		returning created_at, updated_at`,
+		template.Name,
+		template.Fields,
+		template.ID,
+		template.UserID,
+	).Scan(&template.CreatedAt, &template.UpdatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, NewNotUniqueTemplateError(template.Name, err)
+		}
+	}
+	if err == pgx.ErrNoRows {
+		return nil, NewTemplateNotFoundError(template.ID, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (repo *TemplatesRepository) FindTemplate(userID int, templateID int64) (*models.SecretTemplate, error) {
+	var template models.SecretTemplate
+
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"select "+templateColumns+" from secret_templates where id=$1 and user_id=$2",
+		templateID,
+		userID,
+	).Scan(templateFields(&template)...)
+
+	if err == pgx.ErrNoRows {
+		return nil, NewTemplateNotFoundError(templateID, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (repo *TemplatesRepository) ListTemplates(userID int) ([]models.SecretTemplate, error) {
+	rows, err := repo.pool.Query(
+		context.Background(),
+		"select "+templateColumns+" from secret_templates where user_id=$1 order by name",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []models.SecretTemplate
+	for rows.Next() {
+		var template models.SecretTemplate
+		err = rows.Scan(templateFields(&template)...)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+func (repo *TemplatesRepository) DeleteTemplate(userID int, templateID int64) error {
+	tag, err := repo.pool.Exec(
+		context.Background(),
+		"delete from secret_templates where id=$1 and user_id=$2",
+		templateID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewTemplateNotFoundError(templateID, pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+const templateColumns = "id, user_id, name, fields, created_at, updated_at"
+
+// templateFields returns scan destinations matching templateColumns.
+func templateFields(template *models.SecretTemplate) []interface{} {
+	return []interface{}{
+		&template.ID,
+		&template.UserID,
+		&template.Name,
+		&template.Fields,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	}
+}
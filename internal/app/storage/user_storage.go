@@ -80,3 +80,85 @@ func (repo *UsersRepository) Find(username string) (*models.User, error) {
 
 	return &user, err
 }
+
+// GetMinPasswordScore returns the lowest strength score of passwords that user is allowed to save.
+func (repo *UsersRepository) GetMinPasswordScore(userID int) (int, error) {
+	var score int
+
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"select min_password_score from users where id=$1",
+		userID,
+	).Scan(&score)
+
+	return score, err
+}
+
+func (repo *UsersRepository) SetMinPasswordScore(userID int, score int) error {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		"update users set min_password_score=$1 where id=$2",
+		score,
+		userID,
+	)
+
+	return err
+}
+
+// GetKDFParams returns parameters of the key derivation of the user. Salt is nil if they are not set yet.
+func (repo *UsersRepository) GetKDFParams(userID int) (models.KDFParams, error) {
+	var params models.KDFParams
+	var iterations, memory, threads *int64
+
+	err := repo.pool.QueryRow(
+		context.Background(),
+		"select kdf_salt, kdf_key_check, kdf_time, kdf_memory, kdf_threads from users where id=$1",
+		userID,
+	).Scan(&params.Salt, &params.KeyCheck, &iterations, &memory, &threads)
+	if err != nil || params.Salt == nil {
+		return params, err
+	}
+
+	params.Time = uint32(*iterations)
+	params.Memory = uint32(*memory)
+	params.Threads = uint8(*threads)
+
+	return params, nil
+}
+
+// SetKDFParams saves parameters of the key derivation of the user unless they are already set,
+// because changing them would make vault key of the user different.
+// It returns parameters that are saved for the user.
+func (repo *UsersRepository) SetKDFParams(userID int, params models.KDFParams) (models.KDFParams, error) {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		`update users set kdf_salt=$1, kdf_time=$2, kdf_memory=$3, kdf_threads=$4
+		where id=$5 and kdf_salt is null`,
+		params.Salt,
+		int64(params.Time),
+		int64(params.Memory),
+		int16(params.Threads),
+		userID,
+	)
+	if err != nil {
+		return params, err
+	}
+
+	return repo.GetKDFParams(userID)
+}
+
+// SetKDFKeyCheck saves key check of the vault key of the user unless it is already set,
+// because another client may have set it concurrently. It returns parameters that are saved for the user.
+func (repo *UsersRepository) SetKDFKeyCheck(userID int, keyCheck []byte) (models.KDFParams, error) {
+	_, err := repo.pool.Exec(
+		context.Background(),
+		"update users set kdf_key_check=$1 where id=$2 and kdf_salt is not null and kdf_key_check is null",
+		keyCheck,
+		userID,
+	)
+	if err != nil {
+		return models.KDFParams{}, err
+	}
+
+	return repo.GetKDFParams(userID)
+}
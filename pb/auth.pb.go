@@ -224,6 +224,172 @@ func (x *RegisterResponse) GetAccessToken() string {
 	return ""
 }
 
+type KDFParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KDFParamsRequest) Reset() {
+	*x = KDFParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_auth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KDFParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KDFParamsRequest) ProtoMessage() {}
+
+func (x *KDFParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_auth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KDFParamsRequest.ProtoReflect.Descriptor instead.
+func (*KDFParamsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_auth_proto_rawDescGZIP(), []int{4}
+}
+
+// KDFParams are parameters of Argon2id, memory is in KiB
+type KDFParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Salt    []byte `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	Time    uint32 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Memory  uint32 `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Threads uint32 `protobuf:"varint,4,opt,name=threads,proto3" json:"threads,omitempty"`
+	// known value encrypted with vault key, empty until it is set by the client on the first unlock
+	KeyCheck []byte `protobuf:"bytes,5,opt,name=key_check,json=keyCheck,proto3" json:"key_check,omitempty"`
+}
+
+func (x *KDFParams) Reset() {
+	*x = KDFParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_auth_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KDFParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KDFParams) ProtoMessage() {}
+
+func (x *KDFParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_auth_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KDFParams.ProtoReflect.Descriptor instead.
+func (*KDFParams) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KDFParams) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *KDFParams) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *KDFParams) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *KDFParams) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *KDFParams) GetKeyCheck() []byte {
+	if x != nil {
+		return x.KeyCheck
+	}
+	return nil
+}
+
+type KDFKeyCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyCheck []byte `protobuf:"bytes,1,opt,name=key_check,json=keyCheck,proto3" json:"key_check,omitempty"`
+}
+
+func (x *KDFKeyCheckRequest) Reset() {
+	*x = KDFKeyCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_auth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KDFKeyCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KDFKeyCheckRequest) ProtoMessage() {}
+
+func (x *KDFKeyCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_auth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KDFKeyCheckRequest.ProtoReflect.Descriptor instead.
+func (*KDFKeyCheckRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KDFKeyCheckRequest) GetKeyCheck() []byte {
+	if x != nil {
+		return x.KeyCheck
+	}
+	return nil
+}
+
 var File_internal_app_proto_auth_proto protoreflect.FileDescriptor
 
 var file_internal_app_proto_auth_proto_rawDesc = []byte{
@@ -244,14 +410,33 @@ var file_internal_app_proto_auth_proto_rawDesc = []byte{
 	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
 	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x5f, 0x0a,
-	0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0d,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
-	0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06,
-	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x12, 0x0a,
+	0x10, 0x4b, 0x44, 0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x4b, 0x44, 0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x31, 0x0a, 0x12, 0x4b, 0x44, 0x46, 0x4b, 0x65, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6b, 0x65, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x32, 0xc1, 0x01, 0x0a, 0x04, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x4b, 0x44, 0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x11, 0x2e, 0x4b, 0x44,
+	0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x4b, 0x44, 0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x4b, 0x44, 0x46, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x4b,
+	0x44, 0x46, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0a, 0x2e, 0x4b, 0x44, 0x46, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -266,20 +451,27 @@ func file_internal_app_proto_auth_proto_rawDescGZIP() []byte {
 	return file_internal_app_proto_auth_proto_rawDescData
 }
 
-var file_internal_app_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_app_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_internal_app_proto_auth_proto_goTypes = []interface{}{
-	(*LoginRequest)(nil),     // 0: LoginRequest
-	(*LoginResponse)(nil),    // 1: LoginResponse
-	(*RegisterRequest)(nil),  // 2: RegisterRequest
-	(*RegisterResponse)(nil), // 3: RegisterResponse
+	(*LoginRequest)(nil),       // 0: LoginRequest
+	(*LoginResponse)(nil),      // 1: LoginResponse
+	(*RegisterRequest)(nil),    // 2: RegisterRequest
+	(*RegisterResponse)(nil),   // 3: RegisterResponse
+	(*KDFParamsRequest)(nil),   // 4: KDFParamsRequest
+	(*KDFParams)(nil),          // 5: KDFParams
+	(*KDFKeyCheckRequest)(nil), // 6: KDFKeyCheckRequest
 }
 var file_internal_app_proto_auth_proto_depIdxs = []int32{
 	0, // 0: Auth.Login:input_type -> LoginRequest
 	2, // 1: Auth.Register:input_type -> RegisterRequest
-	1, // 2: Auth.Login:output_type -> LoginResponse
-	3, // 3: Auth.Register:output_type -> RegisterResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	4, // 2: Auth.GetKDFParams:input_type -> KDFParamsRequest
+	6, // 3: Auth.SetKDFKeyCheck:input_type -> KDFKeyCheckRequest
+	1, // 4: Auth.Login:output_type -> LoginResponse
+	3, // 5: Auth.Register:output_type -> RegisterResponse
+	5, // 6: Auth.GetKDFParams:output_type -> KDFParams
+	5, // 7: Auth.SetKDFKeyCheck:output_type -> KDFParams
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -339,6 +531,42 @@ func file_internal_app_proto_auth_proto_init() {
 				return nil
 			}
 		}
+		file_internal_app_proto_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KDFParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_auth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KDFParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KDFKeyCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -346,7 +574,7 @@ func file_internal_app_proto_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_app_proto_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type AuthClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// GetKDFParams returns parameters that client uses to derive vault key from master password.
+	// It requires access token.
+	GetKDFParams(ctx context.Context, in *KDFParamsRequest, opts ...grpc.CallOption) (*KDFParams, error)
+	// SetKDFKeyCheck saves key check unless it is already set and returns saved params.
+	// It requires access token.
+	SetKDFKeyCheck(ctx context.Context, in *KDFKeyCheckRequest, opts ...grpc.CallOption) (*KDFParams, error)
 }
 
 type authClient struct {
@@ -52,12 +58,36 @@ func (c *authClient) Register(ctx context.Context, in *RegisterRequest, opts ...
 	return out, nil
 }
 
+func (c *authClient) GetKDFParams(ctx context.Context, in *KDFParamsRequest, opts ...grpc.CallOption) (*KDFParams, error) {
+	out := new(KDFParams)
+	err := c.cc.Invoke(ctx, "/Auth/GetKDFParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SetKDFKeyCheck(ctx context.Context, in *KDFKeyCheckRequest, opts ...grpc.CallOption) (*KDFParams, error) {
+	out := new(KDFParams)
+	err := c.cc.Invoke(ctx, "/Auth/SetKDFKeyCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations must embed UnimplementedAuthServer
 // for forward compatibility
 type AuthServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// GetKDFParams returns parameters that client uses to derive vault key from master password.
+	// It requires access token.
+	GetKDFParams(context.Context, *KDFParamsRequest) (*KDFParams, error)
+	// SetKDFKeyCheck saves key check unless it is already set and returns saved params.
+	// It requires access token.
+	SetKDFKeyCheck(context.Context, *KDFKeyCheckRequest) (*KDFParams, error)
 	mustEmbedUnimplementedAuthServer()
 }
 
@@ -71,6 +101,12 @@ func (UnimplementedAuthServer) Login(context.Context, *LoginRequest) (*LoginResp
 func (UnimplementedAuthServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedAuthServer) GetKDFParams(context.Context, *KDFParamsRequest) (*KDFParams, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKDFParams not implemented")
+}
+func (UnimplementedAuthServer) SetKDFKeyCheck(context.Context, *KDFKeyCheckRequest) (*KDFParams, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKDFKeyCheck not implemented")
+}
 func (UnimplementedAuthServer) mustEmbedUnimplementedAuthServer() {}
 
 // UnsafeAuthServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +156,42 @@ func _Auth_Register_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_GetKDFParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KDFParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetKDFParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Auth/GetKDFParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetKDFParams(ctx, req.(*KDFParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_SetKDFKeyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KDFKeyCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).SetKDFKeyCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Auth/SetKDFKeyCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).SetKDFKeyCheck(ctx, req.(*KDFKeyCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +207,14 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _Auth_Register_Handler,
 		},
+		{
+			MethodName: "GetKDFParams",
+			Handler:    _Auth_GetKDFParams_Handler,
+		},
+		{
+			MethodName: "SetKDFKeyCheck",
+			Handler:    _Auth_SetKDFKeyCheck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/proto/auth.proto",
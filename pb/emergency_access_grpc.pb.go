@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/app/proto/emergency_access.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EmergencyAccessClient is the client API for EmergencyAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmergencyAccessClient interface {
+	// NominateContact makes user an emergency contact, nominating the same user again changes the wait period
+	NominateContact(ctx context.Context, in *NominateContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	// RevokeContact removes emergency contact together with its access
+	RevokeContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// ApproveRequest gives access before the wait period ends
+	ApproveRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	// RejectRequest rejects pending request or takes away access, contact can request it again
+	RejectRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error)
+	// ListGrantors returns users that nominated the user as emergency contact
+	ListGrantors(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// RequestAccess starts the wait period after which the contact gets access unless owner rejects it
+	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*EmergencyContact, error)
+}
+
+type emergencyAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmergencyAccessClient(cc grpc.ClientConnInterface) EmergencyAccessClient {
+	return &emergencyAccessClient{cc}
+}
+
+func (c *emergencyAccessClient) NominateContact(ctx context.Context, in *NominateContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/NominateContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) RevokeContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/RevokeContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/ListContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) ApproveRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/ApproveRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) RejectRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*EmergencyAccessResponse, error) {
+	out := new(EmergencyAccessResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/RejectRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) ListGrantors(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/ListGrantors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessClient) RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*EmergencyContact, error) {
+	out := new(EmergencyContact)
+	err := c.cc.Invoke(ctx, "/EmergencyAccess/RequestAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmergencyAccessServer is the server API for EmergencyAccess service.
+// All implementations must embed UnimplementedEmergencyAccessServer
+// for forward compatibility
+type EmergencyAccessServer interface {
+	// NominateContact makes user an emergency contact, nominating the same user again changes the wait period
+	NominateContact(context.Context, *NominateContactRequest) (*EmergencyAccessResponse, error)
+	// RevokeContact removes emergency contact together with its access
+	RevokeContact(context.Context, *ContactRequest) (*EmergencyAccessResponse, error)
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	// ApproveRequest gives access before the wait period ends
+	ApproveRequest(context.Context, *ContactRequest) (*EmergencyAccessResponse, error)
+	// RejectRequest rejects pending request or takes away access, contact can request it again
+	RejectRequest(context.Context, *ContactRequest) (*EmergencyAccessResponse, error)
+	// ListGrantors returns users that nominated the user as emergency contact
+	ListGrantors(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	// RequestAccess starts the wait period after which the contact gets access unless owner rejects it
+	RequestAccess(context.Context, *RequestAccessRequest) (*EmergencyContact, error)
+	mustEmbedUnimplementedEmergencyAccessServer()
+}
+
+// UnimplementedEmergencyAccessServer must be embedded to have forward compatible implementations.
+type UnimplementedEmergencyAccessServer struct {
+}
+
+func (UnimplementedEmergencyAccessServer) NominateContact(context.Context, *NominateContactRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NominateContact not implemented")
+}
+func (UnimplementedEmergencyAccessServer) RevokeContact(context.Context, *ContactRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeContact not implemented")
+}
+func (UnimplementedEmergencyAccessServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedEmergencyAccessServer) ApproveRequest(context.Context, *ContactRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRequest not implemented")
+}
+func (UnimplementedEmergencyAccessServer) RejectRequest(context.Context, *ContactRequest) (*EmergencyAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRequest not implemented")
+}
+func (UnimplementedEmergencyAccessServer) ListGrantors(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrantors not implemented")
+}
+func (UnimplementedEmergencyAccessServer) RequestAccess(context.Context, *RequestAccessRequest) (*EmergencyContact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedEmergencyAccessServer) mustEmbedUnimplementedEmergencyAccessServer() {}
+
+// UnsafeEmergencyAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmergencyAccessServer will
+// result in compilation errors.
+type UnsafeEmergencyAccessServer interface {
+	mustEmbedUnimplementedEmergencyAccessServer()
+}
+
+func RegisterEmergencyAccessServer(s grpc.ServiceRegistrar, srv EmergencyAccessServer) {
+	s.RegisterService(&EmergencyAccess_ServiceDesc, srv)
+}
+
+func _EmergencyAccess_NominateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NominateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).NominateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/NominateContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).NominateContact(ctx, req.(*NominateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_RevokeContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).RevokeContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/RevokeContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).RevokeContact(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/ListContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_ApproveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).ApproveRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/ApproveRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).ApproveRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_RejectRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).RejectRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/RejectRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).RejectRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_ListGrantors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).ListGrantors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/ListGrantors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).ListGrantors(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccess_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EmergencyAccess/RequestAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmergencyAccess_ServiceDesc is the grpc.ServiceDesc for EmergencyAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmergencyAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "EmergencyAccess",
+	HandlerType: (*EmergencyAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NominateContact",
+			Handler:    _EmergencyAccess_NominateContact_Handler,
+		},
+		{
+			MethodName: "RevokeContact",
+			Handler:    _EmergencyAccess_RevokeContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _EmergencyAccess_ListContacts_Handler,
+		},
+		{
+			MethodName: "ApproveRequest",
+			Handler:    _EmergencyAccess_ApproveRequest_Handler,
+		},
+		{
+			MethodName: "RejectRequest",
+			Handler:    _EmergencyAccess_RejectRequest_Handler,
+		},
+		{
+			MethodName: "ListGrantors",
+			Handler:    _EmergencyAccess_ListGrantors_Handler,
+		},
+		{
+			MethodName: "RequestAccess",
+			Handler:    _EmergencyAccess_RequestAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/proto/emergency_access.proto",
+}
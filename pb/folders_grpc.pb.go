@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/app/proto/folders.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FoldersClient is the client API for Folders service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FoldersClient interface {
+	CreateFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// RenameFolder changes the last element of the path, secrets in the folder are renamed too
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// MoveFolder moves folder with its contents into another folder
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// DeleteFolder deletes folder that has no secrets
+	DeleteFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	ListFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*FolderContents, error)
+}
+
+type foldersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFoldersClient(cc grpc.ClientConnInterface) FoldersClient {
+	return &foldersClient{cc}
+}
+
+func (c *foldersClient) CreateFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/Folders/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/Folders/RenameFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/Folders/MoveFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersClient) DeleteFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, "/Folders/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersClient) ListFolder(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*FolderContents, error) {
+	out := new(FolderContents)
+	err := c.cc.Invoke(ctx, "/Folders/ListFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FoldersServer is the server API for Folders service.
+// All implementations must embed UnimplementedFoldersServer
+// for forward compatibility
+type FoldersServer interface {
+	CreateFolder(context.Context, *FolderRequest) (*Folder, error)
+	// RenameFolder changes the last element of the path, secrets in the folder are renamed too
+	RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error)
+	// MoveFolder moves folder with its contents into another folder
+	MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error)
+	// DeleteFolder deletes folder that has no secrets
+	DeleteFolder(context.Context, *FolderRequest) (*DeleteFolderResponse, error)
+	ListFolder(context.Context, *FolderRequest) (*FolderContents, error)
+	mustEmbedUnimplementedFoldersServer()
+}
+
+// UnimplementedFoldersServer must be embedded to have forward compatible implementations.
+type UnimplementedFoldersServer struct {
+}
+
+func (UnimplementedFoldersServer) CreateFolder(context.Context, *FolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFoldersServer) RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedFoldersServer) MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedFoldersServer) DeleteFolder(context.Context, *FolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedFoldersServer) ListFolder(context.Context, *FolderRequest) (*FolderContents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolder not implemented")
+}
+func (UnimplementedFoldersServer) mustEmbedUnimplementedFoldersServer() {}
+
+// UnsafeFoldersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FoldersServer will
+// result in compilation errors.
+type UnsafeFoldersServer interface {
+	mustEmbedUnimplementedFoldersServer()
+}
+
+func RegisterFoldersServer(s grpc.ServiceRegistrar, srv FoldersServer) {
+	s.RegisterService(&Folders_ServiceDesc, srv)
+}
+
+func _Folders_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Folders/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).CreateFolder(ctx, req.(*FolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Folders_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Folders/RenameFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).RenameFolder(ctx, req.(*RenameFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Folders_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Folders/MoveFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Folders_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Folders/DeleteFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).DeleteFolder(ctx, req.(*FolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Folders_ListFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).ListFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Folders/ListFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).ListFolder(ctx, req.(*FolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Folders_ServiceDesc is the grpc.ServiceDesc for Folders service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Folders_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Folders",
+	HandlerType: (*FoldersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Folders_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _Folders_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _Folders_MoveFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _Folders_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "ListFolder",
+			Handler:    _Folders_ListFolder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/proto/folders.proto",
+}
@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: internal/app/proto/passwords.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EstimatePasswordStrengthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// user_inputs like login are treated as dictionary words
+	UserInputs []string `protobuf:"bytes,2,rep,name=user_inputs,json=userInputs,proto3" json:"user_inputs,omitempty"`
+}
+
+func (x *EstimatePasswordStrengthRequest) Reset() {
+	*x = EstimatePasswordStrengthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatePasswordStrengthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatePasswordStrengthRequest) ProtoMessage() {}
+
+func (x *EstimatePasswordStrengthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatePasswordStrengthRequest.ProtoReflect.Descriptor instead.
+func (*EstimatePasswordStrengthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EstimatePasswordStrengthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EstimatePasswordStrengthRequest) GetUserInputs() []string {
+	if x != nil {
+		return x.UserInputs
+	}
+	return nil
+}
+
+type PasswordStrength struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// score is from 0 (very weak) to 4 (strong)
+	Score int32 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	// estimated entropy in bits
+	Entropy  float64  `protobuf:"fixed64,2,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *PasswordStrength) Reset() {
+	*x = PasswordStrength{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordStrength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordStrength) ProtoMessage() {}
+
+func (x *PasswordStrength) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordStrength.ProtoReflect.Descriptor instead.
+func (*PasswordStrength) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PasswordStrength) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetEntropy() float64 {
+	if x != nil {
+		return x.Entropy
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetPasswordPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPasswordPolicyRequest) Reset() {
+	*x = GetPasswordPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *GetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{2}
+}
+
+type PasswordPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 allows any password
+	MinScore int32 `protobuf:"varint,1,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PasswordPolicy) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+// GenerationPolicy describes random password. Zero length means 20 characters,
+// if no class is enabled all of them are used
+type GenerationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length     int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Lower      bool  `protobuf:"varint,2,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper      bool  `protobuf:"varint,3,opt,name=upper,proto3" json:"upper,omitempty"`
+	Digits     bool  `protobuf:"varint,4,opt,name=digits,proto3" json:"digits,omitempty"`
+	Symbols    bool  `protobuf:"varint,5,opt,name=symbols,proto3" json:"symbols,omitempty"`
+	MinLower   int32 `protobuf:"varint,6,opt,name=min_lower,json=minLower,proto3" json:"min_lower,omitempty"`
+	MinUpper   int32 `protobuf:"varint,7,opt,name=min_upper,json=minUpper,proto3" json:"min_upper,omitempty"`
+	MinDigits  int32 `protobuf:"varint,8,opt,name=min_digits,json=minDigits,proto3" json:"min_digits,omitempty"`
+	MinSymbols int32 `protobuf:"varint,9,opt,name=min_symbols,json=minSymbols,proto3" json:"min_symbols,omitempty"`
+	// exclude characters like l, 1, O and 0
+	ExcludeAmbiguous bool `protobuf:"varint,10,opt,name=exclude_ambiguous,json=excludeAmbiguous,proto3" json:"exclude_ambiguous,omitempty"`
+}
+
+func (x *GenerationPolicy) Reset() {
+	*x = GenerationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerationPolicy) ProtoMessage() {}
+
+func (x *GenerationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerationPolicy.ProtoReflect.Descriptor instead.
+func (*GenerationPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerationPolicy) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GenerationPolicy) GetLower() bool {
+	if x != nil {
+		return x.Lower
+	}
+	return false
+}
+
+func (x *GenerationPolicy) GetUpper() bool {
+	if x != nil {
+		return x.Upper
+	}
+	return false
+}
+
+func (x *GenerationPolicy) GetDigits() bool {
+	if x != nil {
+		return x.Digits
+	}
+	return false
+}
+
+func (x *GenerationPolicy) GetSymbols() bool {
+	if x != nil {
+		return x.Symbols
+	}
+	return false
+}
+
+func (x *GenerationPolicy) GetMinLower() int32 {
+	if x != nil {
+		return x.MinLower
+	}
+	return 0
+}
+
+func (x *GenerationPolicy) GetMinUpper() int32 {
+	if x != nil {
+		return x.MinUpper
+	}
+	return 0
+}
+
+func (x *GenerationPolicy) GetMinDigits() int32 {
+	if x != nil {
+		return x.MinDigits
+	}
+	return 0
+}
+
+func (x *GenerationPolicy) GetMinSymbols() int32 {
+	if x != nil {
+		return x.MinSymbols
+	}
+	return 0
+}
+
+func (x *GenerationPolicy) GetExcludeAmbiguous() bool {
+	if x != nil {
+		return x.ExcludeAmbiguous
+	}
+	return false
+}
+
+// PassphrasePolicy describes diceware passphrase. Zero words means 6 words, empty divider means "-"
+type PassphrasePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words         int32  `protobuf:"varint,1,opt,name=words,proto3" json:"words,omitempty"`
+	Divider       string `protobuf:"bytes,2,opt,name=divider,proto3" json:"divider,omitempty"`
+	Capitalize    bool   `protobuf:"varint,3,opt,name=capitalize,proto3" json:"capitalize,omitempty"`
+	IncludeNumber bool   `protobuf:"varint,4,opt,name=include_number,json=includeNumber,proto3" json:"include_number,omitempty"`
+}
+
+func (x *PassphrasePolicy) Reset() {
+	*x = PassphrasePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassphrasePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassphrasePolicy) ProtoMessage() {}
+
+func (x *PassphrasePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassphrasePolicy.ProtoReflect.Descriptor instead.
+func (*PassphrasePolicy) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PassphrasePolicy) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *PassphrasePolicy) GetDivider() string {
+	if x != nil {
+		return x.Divider
+	}
+	return ""
+}
+
+func (x *PassphrasePolicy) GetCapitalize() bool {
+	if x != nil {
+		return x.Capitalize
+	}
+	return false
+}
+
+func (x *PassphrasePolicy) GetIncludeNumber() bool {
+	if x != nil {
+		return x.IncludeNumber
+	}
+	return false
+}
+
+// GeneratePasswordRequest without policy generates password with default policy
+type GeneratePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Policy:
+	//	*GeneratePasswordRequest_Password
+	//	*GeneratePasswordRequest_Passphrase
+	Policy isGeneratePasswordRequest_Policy `protobuf_oneof:"policy"`
+}
+
+func (x *GeneratePasswordRequest) Reset() {
+	*x = GeneratePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePasswordRequest) ProtoMessage() {}
+
+func (x *GeneratePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePasswordRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *GeneratePasswordRequest) GetPolicy() isGeneratePasswordRequest_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *GeneratePasswordRequest) GetPassword() *GenerationPolicy {
+	if x, ok := x.GetPolicy().(*GeneratePasswordRequest_Password); ok {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *GeneratePasswordRequest) GetPassphrase() *PassphrasePolicy {
+	if x, ok := x.GetPolicy().(*GeneratePasswordRequest_Passphrase); ok {
+		return x.Passphrase
+	}
+	return nil
+}
+
+type isGeneratePasswordRequest_Policy interface {
+	isGeneratePasswordRequest_Policy()
+}
+
+type GeneratePasswordRequest_Password struct {
+	Password *GenerationPolicy `protobuf:"bytes,1,opt,name=password,proto3,oneof"`
+}
+
+type GeneratePasswordRequest_Passphrase struct {
+	Passphrase *PassphrasePolicy `protobuf:"bytes,2,opt,name=passphrase,proto3,oneof"`
+}
+
+func (*GeneratePasswordRequest_Password) isGeneratePasswordRequest_Policy() {}
+
+func (*GeneratePasswordRequest_Passphrase) isGeneratePasswordRequest_Policy() {}
+
+type GeneratePasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string            `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Strength *PasswordStrength `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+}
+
+func (x *GeneratePasswordResponse) Reset() {
+	*x = GeneratePasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePasswordResponse) ProtoMessage() {}
+
+func (x *GeneratePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePasswordResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GeneratePasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GeneratePasswordResponse) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+type CheckBreachedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//	*CheckBreachedRequest_Password
+	//	*CheckBreachedRequest_SecretName
+	Target isCheckBreachedRequest_Target `protobuf_oneof:"target"`
+}
+
+func (x *CheckBreachedRequest) Reset() {
+	*x = CheckBreachedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckBreachedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBreachedRequest) ProtoMessage() {}
+
+func (x *CheckBreachedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBreachedRequest.ProtoReflect.Descriptor instead.
+func (*CheckBreachedRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *CheckBreachedRequest) GetTarget() isCheckBreachedRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *CheckBreachedRequest) GetPassword() string {
+	if x, ok := x.GetTarget().(*CheckBreachedRequest_Password); ok {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CheckBreachedRequest) GetSecretName() string {
+	if x, ok := x.GetTarget().(*CheckBreachedRequest_SecretName); ok {
+		return x.SecretName
+	}
+	return ""
+}
+
+type isCheckBreachedRequest_Target interface {
+	isCheckBreachedRequest_Target()
+}
+
+type CheckBreachedRequest_Password struct {
+	// candidate password
+	Password string `protobuf:"bytes,1,opt,name=password,proto3,oneof"`
+}
+
+type CheckBreachedRequest_SecretName struct {
+	// name of stored password secret
+	SecretName string `protobuf:"bytes,2,opt,name=secret_name,json=secretName,proto3,oneof"`
+}
+
+func (*CheckBreachedRequest_Password) isCheckBreachedRequest_Target() {}
+
+func (*CheckBreachedRequest_SecretName) isCheckBreachedRequest_Target() {}
+
+type BreachCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Breached bool `protobuf:"varint,1,opt,name=breached,proto3" json:"breached,omitempty"`
+	// how many times password was seen in breaches
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BreachCheck) Reset() {
+	*x = BreachCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreachCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachCheck) ProtoMessage() {}
+
+func (x *BreachCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachCheck.ProtoReflect.Descriptor instead.
+func (*BreachCheck) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BreachCheck) GetBreached() bool {
+	if x != nil {
+		return x.Breached
+	}
+	return false
+}
+
+func (x *BreachCheck) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type BreachRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// first 5 hex characters of sha-1 hash of password
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *BreachRangeRequest) Reset() {
+	*x = BreachRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreachRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachRangeRequest) ProtoMessage() {}
+
+func (x *BreachRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachRangeRequest.ProtoReflect.Descriptor instead.
+func (*BreachRangeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BreachRangeRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type BreachedHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex characters of sha-1 hash after the prefix
+	Suffix string `protobuf:"bytes,1,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Count  int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BreachedHash) Reset() {
+	*x = BreachedHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreachedHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachedHash) ProtoMessage() {}
+
+func (x *BreachedHash) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachedHash.ProtoReflect.Descriptor instead.
+func (*BreachedHash) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BreachedHash) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+func (x *BreachedHash) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type BreachRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes []*BreachedHash `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *BreachRange) Reset() {
+	*x = BreachRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_passwords_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreachRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachRange) ProtoMessage() {}
+
+func (x *BreachRange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_passwords_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachRange.ProtoReflect.Descriptor instead.
+func (*BreachRange) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_passwords_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BreachRange) GetHashes() []*BreachedHash {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+var File_internal_app_proto_passwords_proto protoreflect.FileDescriptor
+
+var file_internal_app_proto_passwords_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x1f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x2d, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0xaf, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x55,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x67,
+	0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x61, 0x6d, 0x62, 0x69, 0x67, 0x75, 0x6f, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6d, 0x62, 0x69, 0x67, 0x75, 0x6f, 0x75,
+	0x73, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x69, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x69,
+	0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x00,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0x61, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x22, 0x3c, 0x0a, 0x0c, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x34, 0x0a, 0x0b, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x32, 0x8c, 0x03, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x18, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x20, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0f, 0x2e, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x0f, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a,
+	0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x15, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x13, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_app_proto_passwords_proto_rawDescOnce sync.Once
+	file_internal_app_proto_passwords_proto_rawDescData = file_internal_app_proto_passwords_proto_rawDesc
+)
+
+func file_internal_app_proto_passwords_proto_rawDescGZIP() []byte {
+	file_internal_app_proto_passwords_proto_rawDescOnce.Do(func() {
+		file_internal_app_proto_passwords_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_app_proto_passwords_proto_rawDescData)
+	})
+	return file_internal_app_proto_passwords_proto_rawDescData
+}
+
+var file_internal_app_proto_passwords_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_internal_app_proto_passwords_proto_goTypes = []interface{}{
+	(*EstimatePasswordStrengthRequest)(nil), // 0: EstimatePasswordStrengthRequest
+	(*PasswordStrength)(nil),                // 1: PasswordStrength
+	(*GetPasswordPolicyRequest)(nil),        // 2: GetPasswordPolicyRequest
+	(*PasswordPolicy)(nil),                  // 3: PasswordPolicy
+	(*GenerationPolicy)(nil),                // 4: GenerationPolicy
+	(*PassphrasePolicy)(nil),                // 5: PassphrasePolicy
+	(*GeneratePasswordRequest)(nil),         // 6: GeneratePasswordRequest
+	(*GeneratePasswordResponse)(nil),        // 7: GeneratePasswordResponse
+	(*CheckBreachedRequest)(nil),            // 8: CheckBreachedRequest
+	(*BreachCheck)(nil),                     // 9: BreachCheck
+	(*BreachRangeRequest)(nil),              // 10: BreachRangeRequest
+	(*BreachedHash)(nil),                    // 11: BreachedHash
+	(*BreachRange)(nil),                     // 12: BreachRange
+}
+var file_internal_app_proto_passwords_proto_depIdxs = []int32{
+	4,  // 0: GeneratePasswordRequest.password:type_name -> GenerationPolicy
+	5,  // 1: GeneratePasswordRequest.passphrase:type_name -> PassphrasePolicy
+	1,  // 2: GeneratePasswordResponse.strength:type_name -> PasswordStrength
+	11, // 3: BreachRange.hashes:type_name -> BreachedHash
+	0,  // 4: Passwords.EstimatePasswordStrength:input_type -> EstimatePasswordStrengthRequest
+	2,  // 5: Passwords.GetPasswordPolicy:input_type -> GetPasswordPolicyRequest
+	3,  // 6: Passwords.SetPasswordPolicy:input_type -> PasswordPolicy
+	6,  // 7: Passwords.GeneratePassword:input_type -> GeneratePasswordRequest
+	8,  // 8: Passwords.CheckBreached:input_type -> CheckBreachedRequest
+	10, // 9: Passwords.CheckBreachedRange:input_type -> BreachRangeRequest
+	1,  // 10: Passwords.EstimatePasswordStrength:output_type -> PasswordStrength
+	3,  // 11: Passwords.GetPasswordPolicy:output_type -> PasswordPolicy
+	3,  // 12: Passwords.SetPasswordPolicy:output_type -> PasswordPolicy
+	7,  // 13: Passwords.GeneratePassword:output_type -> GeneratePasswordResponse
+	9,  // 14: Passwords.CheckBreached:output_type -> BreachCheck
+	12, // 15: Passwords.CheckBreachedRange:output_type -> BreachRange
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_internal_app_proto_passwords_proto_init() }
+func file_internal_app_proto_passwords_proto_init() {
+	if File_internal_app_proto_passwords_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_app_proto_passwords_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatePasswordStrengthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordStrength); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPasswordPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassphrasePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckBreachedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreachCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreachRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreachedHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_passwords_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreachRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_app_proto_passwords_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*GeneratePasswordRequest_Password)(nil),
+		(*GeneratePasswordRequest_Passphrase)(nil),
+	}
+	file_internal_app_proto_passwords_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*CheckBreachedRequest_Password)(nil),
+		(*CheckBreachedRequest_SecretName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_app_proto_passwords_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_app_proto_passwords_proto_goTypes,
+		DependencyIndexes: file_internal_app_proto_passwords_proto_depIdxs,
+		MessageInfos:      file_internal_app_proto_passwords_proto_msgTypes,
+	}.Build()
+	File_internal_app_proto_passwords_proto = out.File
+	file_internal_app_proto_passwords_proto_rawDesc = nil
+	file_internal_app_proto_passwords_proto_goTypes = nil
+	file_internal_app_proto_passwords_proto_depIdxs = nil
+}
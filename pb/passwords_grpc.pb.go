@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/app/proto/passwords.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PasswordsClient is the client API for Passwords service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PasswordsClient interface {
+	EstimatePasswordStrength(ctx context.Context, in *EstimatePasswordStrengthRequest, opts ...grpc.CallOption) (*PasswordStrength, error)
+	GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	// SetPasswordPolicy makes saving of passwords weaker than min_score fail
+	SetPasswordPolicy(ctx context.Context, in *PasswordPolicy, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error)
+	// CheckBreached looks up password in local corpus of breached passwords,
+	// fails with FAILED_PRECONDITION if server has no corpus
+	CheckBreached(ctx context.Context, in *CheckBreachedRequest, opts ...grpc.CallOption) (*BreachCheck, error)
+	// CheckBreachedRange returns breached hashes that start with the prefix, so client checks password
+	// without sending it or its full hash, fails with FAILED_PRECONDITION if server has no corpus
+	CheckBreachedRange(ctx context.Context, in *BreachRangeRequest, opts ...grpc.CallOption) (*BreachRange, error)
+}
+
+type passwordsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasswordsClient(cc grpc.ClientConnInterface) PasswordsClient {
+	return &passwordsClient{cc}
+}
+
+func (c *passwordsClient) EstimatePasswordStrength(ctx context.Context, in *EstimatePasswordStrengthRequest, opts ...grpc.CallOption) (*PasswordStrength, error) {
+	out := new(PasswordStrength)
+	err := c.cc.Invoke(ctx, "/Passwords/EstimatePasswordStrength", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, "/Passwords/GetPasswordPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) SetPasswordPolicy(ctx context.Context, in *PasswordPolicy, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, "/Passwords/SetPasswordPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error) {
+	out := new(GeneratePasswordResponse)
+	err := c.cc.Invoke(ctx, "/Passwords/GeneratePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) CheckBreached(ctx context.Context, in *CheckBreachedRequest, opts ...grpc.CallOption) (*BreachCheck, error) {
+	out := new(BreachCheck)
+	err := c.cc.Invoke(ctx, "/Passwords/CheckBreached", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) CheckBreachedRange(ctx context.Context, in *BreachRangeRequest, opts ...grpc.CallOption) (*BreachRange, error) {
+	out := new(BreachRange)
+	err := c.cc.Invoke(ctx, "/Passwords/CheckBreachedRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasswordsServer is the server API for Passwords service.
+// All implementations must embed UnimplementedPasswordsServer
+// for forward compatibility
+type PasswordsServer interface {
+	EstimatePasswordStrength(context.Context, *EstimatePasswordStrengthRequest) (*PasswordStrength, error)
+	GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error)
+	// SetPasswordPolicy makes saving of passwords weaker than min_score fail
+	SetPasswordPolicy(context.Context, *PasswordPolicy) (*PasswordPolicy, error)
+	GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error)
+	// CheckBreached looks up password in local corpus of breached passwords,
+	// fails with FAILED_PRECONDITION if server has no corpus
+	CheckBreached(context.Context, *CheckBreachedRequest) (*BreachCheck, error)
+	// CheckBreachedRange returns breached hashes that start with the prefix, so client checks password
+	// without sending it or its full hash, fails with FAILED_PRECONDITION if server has no corpus
+	CheckBreachedRange(context.Context, *BreachRangeRequest) (*BreachRange, error)
+	mustEmbedUnimplementedPasswordsServer()
+}
+
+// UnimplementedPasswordsServer must be embedded to have forward compatible implementations.
+type UnimplementedPasswordsServer struct {
+}
+
+func (UnimplementedPasswordsServer) EstimatePasswordStrength(context.Context, *EstimatePasswordStrengthRequest) (*PasswordStrength, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatePasswordStrength not implemented")
+}
+func (UnimplementedPasswordsServer) GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasswordPolicy not implemented")
+}
+func (UnimplementedPasswordsServer) SetPasswordPolicy(context.Context, *PasswordPolicy) (*PasswordPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPasswordPolicy not implemented")
+}
+func (UnimplementedPasswordsServer) GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePassword not implemented")
+}
+func (UnimplementedPasswordsServer) CheckBreached(context.Context, *CheckBreachedRequest) (*BreachCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBreached not implemented")
+}
+func (UnimplementedPasswordsServer) CheckBreachedRange(context.Context, *BreachRangeRequest) (*BreachRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBreachedRange not implemented")
+}
+func (UnimplementedPasswordsServer) mustEmbedUnimplementedPasswordsServer() {}
+
+// UnsafePasswordsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasswordsServer will
+// result in compilation errors.
+type UnsafePasswordsServer interface {
+	mustEmbedUnimplementedPasswordsServer()
+}
+
+func RegisterPasswordsServer(s grpc.ServiceRegistrar, srv PasswordsServer) {
+	s.RegisterService(&Passwords_ServiceDesc, srv)
+}
+
+func _Passwords_EstimatePasswordStrength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimatePasswordStrengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).EstimatePasswordStrength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/EstimatePasswordStrength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).EstimatePasswordStrength(ctx, req.(*EstimatePasswordStrengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_GetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).GetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/GetPasswordPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).GetPasswordPolicy(ctx, req.(*GetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_SetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).SetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/SetPasswordPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).SetPasswordPolicy(ctx, req.(*PasswordPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_GeneratePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).GeneratePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/GeneratePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).GeneratePassword(ctx, req.(*GeneratePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_CheckBreached_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBreachedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).CheckBreached(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/CheckBreached",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).CheckBreached(ctx, req.(*CheckBreachedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_CheckBreachedRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreachRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).CheckBreachedRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Passwords/CheckBreachedRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).CheckBreachedRange(ctx, req.(*BreachRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Passwords_ServiceDesc is the grpc.ServiceDesc for Passwords service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Passwords_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Passwords",
+	HandlerType: (*PasswordsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimatePasswordStrength",
+			Handler:    _Passwords_EstimatePasswordStrength_Handler,
+		},
+		{
+			MethodName: "GetPasswordPolicy",
+			Handler:    _Passwords_GetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "SetPasswordPolicy",
+			Handler:    _Passwords_SetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "GeneratePassword",
+			Handler:    _Passwords_GeneratePassword_Handler,
+		},
+		{
+			MethodName: "CheckBreached",
+			Handler:    _Passwords_CheckBreached_Handler,
+		},
+		{
+			MethodName: "CheckBreachedRange",
+			Handler:    _Passwords_CheckBreachedRange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/proto/passwords.proto",
+}
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,281 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SecretType int32
+
+const (
+	SecretType_SECRET_TYPE_UNSPECIFIED SecretType = 0
+	SecretType_SECRET_TYPE_PASSWORD    SecretType = 1
+	SecretType_SECRET_TYPE_CARD        SecretType = 2
+	SecretType_SECRET_TYPE_TEXT        SecretType = 3
+	SecretType_SECRET_TYPE_BINARY      SecretType = 4
+	SecretType_SECRET_TYPE_CUSTOM      SecretType = 5
+	SecretType_SECRET_TYPE_TOTP        SecretType = 6
+	SecretType_SECRET_TYPE_SSH_KEY     SecretType = 7
+)
+
+// Enum value maps for SecretType.
+var (
+	SecretType_name = map[int32]string{
+		0: "SECRET_TYPE_UNSPECIFIED",
+		1: "SECRET_TYPE_PASSWORD",
+		2: "SECRET_TYPE_CARD",
+		3: "SECRET_TYPE_TEXT",
+		4: "SECRET_TYPE_BINARY",
+		5: "SECRET_TYPE_CUSTOM",
+		6: "SECRET_TYPE_TOTP",
+		7: "SECRET_TYPE_SSH_KEY",
+	}
+	SecretType_value = map[string]int32{
+		"SECRET_TYPE_UNSPECIFIED": 0,
+		"SECRET_TYPE_PASSWORD":    1,
+		"SECRET_TYPE_CARD":        2,
+		"SECRET_TYPE_TEXT":        3,
+		"SECRET_TYPE_BINARY":      4,
+		"SECRET_TYPE_CUSTOM":      5,
+		"SECRET_TYPE_TOTP":        6,
+		"SECRET_TYPE_SSH_KEY":     7,
+	}
+)
+
+func (x SecretType) Enum() *SecretType {
+	p := new(SecretType)
+	*p = x
+	return p
+}
+
+func (x SecretType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_proto_secrets_proto_enumTypes[0].Descriptor()
+}
+
+func (SecretType) Type() protoreflect.EnumType {
+	return &file_internal_app_proto_secrets_proto_enumTypes[0]
+}
+
+func (x SecretType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretType.Descriptor instead.
+func (SecretType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{0}
+}
+
+// URLMatch is a rule of matching URL of password secret, unspecified means base domain
+type URLMatch int32
+
+const (
+	URLMatch_URL_MATCH_UNSPECIFIED URLMatch = 0
+	// any host of the same registrable domain, like gitlab.example.com for example.com
+	URLMatch_URL_MATCH_BASE_DOMAIN URLMatch = 1
+	// the same host and port
+	URLMatch_URL_MATCH_HOST URLMatch = 2
+	// URLs that start with the url
+	URLMatch_URL_MATCH_PREFIX URLMatch = 3
+	// url is a regular expression
+	URLMatch_URL_MATCH_REGEX URLMatch = 4
+)
+
+// Enum value maps for URLMatch.
+var (
+	URLMatch_name = map[int32]string{
+		0: "URL_MATCH_UNSPECIFIED",
+		1: "URL_MATCH_BASE_DOMAIN",
+		2: "URL_MATCH_HOST",
+		3: "URL_MATCH_PREFIX",
+		4: "URL_MATCH_REGEX",
+	}
+	URLMatch_value = map[string]int32{
+		"URL_MATCH_UNSPECIFIED": 0,
+		"URL_MATCH_BASE_DOMAIN": 1,
+		"URL_MATCH_HOST":        2,
+		"URL_MATCH_PREFIX":      3,
+		"URL_MATCH_REGEX":       4,
+	}
+)
+
+func (x URLMatch) Enum() *URLMatch {
+	p := new(URLMatch)
+	*p = x
+	return p
+}
+
+func (x URLMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (URLMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_proto_secrets_proto_enumTypes[1].Descriptor()
+}
+
+func (URLMatch) Type() protoreflect.EnumType {
+	return &file_internal_app_proto_secrets_proto_enumTypes[1]
+}
+
+func (x URLMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use URLMatch.Descriptor instead.
+func (URLMatch) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{1}
+}
+
+type TOTPAlgorithm int32
+
+const (
+	TOTPAlgorithm_TOTP_ALGORITHM_UNSPECIFIED TOTPAlgorithm = 0
+	TOTPAlgorithm_TOTP_ALGORITHM_SHA1        TOTPAlgorithm = 1
+	TOTPAlgorithm_TOTP_ALGORITHM_SHA256      TOTPAlgorithm = 2
+	TOTPAlgorithm_TOTP_ALGORITHM_SHA512      TOTPAlgorithm = 3
+)
+
+// Enum value maps for TOTPAlgorithm.
+var (
+	TOTPAlgorithm_name = map[int32]string{
+		0: "TOTP_ALGORITHM_UNSPECIFIED",
+		1: "TOTP_ALGORITHM_SHA1",
+		2: "TOTP_ALGORITHM_SHA256",
+		3: "TOTP_ALGORITHM_SHA512",
+	}
+	TOTPAlgorithm_value = map[string]int32{
+		"TOTP_ALGORITHM_UNSPECIFIED": 0,
+		"TOTP_ALGORITHM_SHA1":        1,
+		"TOTP_ALGORITHM_SHA256":      2,
+		"TOTP_ALGORITHM_SHA512":      3,
+	}
+)
+
+func (x TOTPAlgorithm) Enum() *TOTPAlgorithm {
+	p := new(TOTPAlgorithm)
+	*p = x
+	return p
+}
+
+func (x TOTPAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TOTPAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_proto_secrets_proto_enumTypes[2].Descriptor()
+}
+
+func (TOTPAlgorithm) Type() protoreflect.EnumType {
+	return &file_internal_app_proto_secrets_proto_enumTypes[2]
+}
+
+func (x TOTPAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TOTPAlgorithm.Descriptor instead.
+func (TOTPAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{2}
+}
+
+type DueReason int32
+
+const (
+	DueReason_DUE_REASON_UNSPECIFIED DueReason = 0
+	DueReason_DUE_REASON_EXPIRY      DueReason = 1
+	DueReason_DUE_REASON_ROTATION    DueReason = 2
+)
+
+// Enum value maps for DueReason.
+var (
+	DueReason_name = map[int32]string{
+		0: "DUE_REASON_UNSPECIFIED",
+		1: "DUE_REASON_EXPIRY",
+		2: "DUE_REASON_ROTATION",
+	}
+	DueReason_value = map[string]int32{
+		"DUE_REASON_UNSPECIFIED": 0,
+		"DUE_REASON_EXPIRY":      1,
+		"DUE_REASON_ROTATION":    2,
+	}
+)
+
+func (x DueReason) Enum() *DueReason {
+	p := new(DueReason)
+	*p = x
+	return p
+}
+
+func (x DueReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DueReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_proto_secrets_proto_enumTypes[3].Descriptor()
+}
+
+func (DueReason) Type() protoreflect.EnumType {
+	return &file_internal_app_proto_secrets_proto_enumTypes[3]
+}
+
+func (x DueReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DueReason.Descriptor instead.
+func (DueReason) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{3}
+}
+
+type SharePermission int32
+
+const (
+	SharePermission_SHARE_PERMISSION_UNSPECIFIED SharePermission = 0
+	SharePermission_SHARE_PERMISSION_READ        SharePermission = 1
+	// read-write permission also allows to update the secret
+	SharePermission_SHARE_PERMISSION_WRITE SharePermission = 2
+)
+
+// Enum value maps for SharePermission.
+var (
+	SharePermission_name = map[int32]string{
+		0: "SHARE_PERMISSION_UNSPECIFIED",
+		1: "SHARE_PERMISSION_READ",
+		2: "SHARE_PERMISSION_WRITE",
+	}
+	SharePermission_value = map[string]int32{
+		"SHARE_PERMISSION_UNSPECIFIED": 0,
+		"SHARE_PERMISSION_READ":        1,
+		"SHARE_PERMISSION_WRITE":       2,
+	}
+)
+
+func (x SharePermission) Enum() *SharePermission {
+	p := new(SharePermission)
+	*p = x
+	return p
+}
+
+func (x SharePermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SharePermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_proto_secrets_proto_enumTypes[4].Descriptor()
+}
+
+func (SharePermission) Type() protoreflect.EnumType {
+	return &file_internal_app_proto_secrets_proto_enumTypes[4]
+}
+
+func (x SharePermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SharePermission.Descriptor instead.
+func (SharePermission) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{4}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -64,6 +340,10 @@ type GetSecretRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is required by GetSecret and ignored by per type RPCs
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	// shared_id is id of the secret shared with the user, name is ignored if it is set
+	SharedId int64 `protobuf:"varint,3,opt,name=shared_id,json=sharedId,proto3" json:"shared_id,omitempty"`
 }
 
 func (x *GetSecretRequest) Reset() {
@@ -105,18 +385,32 @@ func (x *GetSecretRequest) GetName() string {
 	return ""
 }
 
-type SavePasswordRequest struct {
+func (x *GetSecretRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *GetSecretRequest) GetSharedId() int64 {
+	if x != nil {
+		return x.SharedId
+	}
+	return 0
+}
+
+type PasswordURL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Login    string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// URLs without scheme are treated as https
+	Url   string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Match URLMatch `protobuf:"varint,2,opt,name=match,proto3,enum=URLMatch" json:"match,omitempty"`
 }
 
-func (x *SavePasswordRequest) Reset() {
-	*x = SavePasswordRequest{}
+func (x *PasswordURL) Reset() {
+	*x = PasswordURL{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -124,13 +418,13 @@ func (x *SavePasswordRequest) Reset() {
 	}
 }
 
-func (x *SavePasswordRequest) String() string {
+func (x *PasswordURL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavePasswordRequest) ProtoMessage() {}
+func (*PasswordURL) ProtoMessage() {}
 
-func (x *SavePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *PasswordURL) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,43 +436,37 @@ func (x *SavePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavePasswordRequest.ProtoReflect.Descriptor instead.
-func (*SavePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordURL.ProtoReflect.Descriptor instead.
+func (*PasswordURL) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SavePasswordRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SavePasswordRequest) GetLogin() string {
+func (x *PasswordURL) GetUrl() string {
 	if x != nil {
-		return x.Login
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SavePasswordRequest) GetPassword() string {
+func (x *PasswordURL) GetMatch() URLMatch {
 	if x != nil {
-		return x.Password
+		return x.Match
 	}
-	return ""
+	return URLMatch_URL_MATCH_UNSPECIFIED
 }
 
-type PasswordResponse struct {
+type PasswordData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Login    string         `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string         `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Urls     []*PasswordURL `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
 }
 
-func (x *PasswordResponse) Reset() {
-	*x = PasswordResponse{}
+func (x *PasswordData) Reset() {
+	*x = PasswordData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -186,13 +474,13 @@ func (x *PasswordResponse) Reset() {
 	}
 }
 
-func (x *PasswordResponse) String() string {
+func (x *PasswordData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordResponse) ProtoMessage() {}
+func (*PasswordData) ProtoMessage() {}
 
-func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *PasswordData) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,39 +492,49 @@ func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
-func (*PasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordData.ProtoReflect.Descriptor instead.
+func (*PasswordData) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PasswordResponse) GetLogin() string {
+func (x *PasswordData) GetLogin() string {
 	if x != nil {
 		return x.Login
 	}
 	return ""
 }
 
-func (x *PasswordResponse) GetPassword() string {
+func (x *PasswordData) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type SaveCardRequest struct {
+func (x *PasswordData) GetUrls() []*PasswordURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type CardData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardName   string `protobuf:"bytes,1,opt,name=cardName,proto3" json:"cardName,omitempty"`
-	Number     string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	HolderName string `protobuf:"bytes,3,opt,name=holderName,proto3" json:"holderName,omitempty"`
-	Date       string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Ccv        string `protobuf:"bytes,5,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	Number     string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	HolderName string `protobuf:"bytes,2,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	// MM/YY
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Ccv  string `protobuf:"bytes,4,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	// brand and last4 are set by server and ignored on save
+	Brand string `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`
+	Last4 string `protobuf:"bytes,6,opt,name=last4,proto3" json:"last4,omitempty"`
 }
 
-func (x *SaveCardRequest) Reset() {
-	*x = SaveCardRequest{}
+func (x *CardData) Reset() {
+	*x = CardData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +542,13 @@ func (x *SaveCardRequest) Reset() {
 	}
 }
 
-func (x *SaveCardRequest) String() string {
+func (x *CardData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveCardRequest) ProtoMessage() {}
+func (*CardData) ProtoMessage() {}
 
-func (x *SaveCardRequest) ProtoReflect() protoreflect.Message {
+func (x *CardData) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,59 +560,63 @@ func (x *SaveCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveCardRequest.ProtoReflect.Descriptor instead.
-func (*SaveCardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CardData.ProtoReflect.Descriptor instead.
+func (*CardData) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SaveCardRequest) GetCardName() string {
+func (x *CardData) GetNumber() string {
 	if x != nil {
-		return x.CardName
+		return x.Number
 	}
 	return ""
 }
 
-func (x *SaveCardRequest) GetNumber() string {
+func (x *CardData) GetHolderName() string {
 	if x != nil {
-		return x.Number
+		return x.HolderName
 	}
 	return ""
 }
 
-func (x *SaveCardRequest) GetHolderName() string {
+func (x *CardData) GetDate() string {
 	if x != nil {
-		return x.HolderName
+		return x.Date
 	}
 	return ""
 }
 
-func (x *SaveCardRequest) GetDate() string {
+func (x *CardData) GetCcv() string {
 	if x != nil {
-		return x.Date
+		return x.Ccv
 	}
 	return ""
 }
 
-func (x *SaveCardRequest) GetCcv() string {
+func (x *CardData) GetBrand() string {
 	if x != nil {
-		return x.Ccv
+		return x.Brand
 	}
 	return ""
 }
 
-type CardResponse struct {
+func (x *CardData) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+type TextData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number     string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
-	HolderName string `protobuf:"bytes,2,opt,name=holderName,proto3" json:"holderName,omitempty"`
-	Date       string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Ccv        string `protobuf:"bytes,4,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *CardResponse) Reset() {
-	*x = CardResponse{}
+func (x *TextData) Reset() {
+	*x = TextData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,13 +624,13 @@ func (x *CardResponse) Reset() {
 	}
 }
 
-func (x *CardResponse) String() string {
+func (x *TextData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardResponse) ProtoMessage() {}
+func (*TextData) ProtoMessage() {}
 
-func (x *CardResponse) ProtoReflect() protoreflect.Message {
+func (x *TextData) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,50 +642,30 @@ func (x *CardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardResponse.ProtoReflect.Descriptor instead.
-func (*CardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextData.ProtoReflect.Descriptor instead.
+func (*TextData) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CardResponse) GetNumber() string {
-	if x != nil {
-		return x.Number
-	}
-	return ""
-}
-
-func (x *CardResponse) GetHolderName() string {
-	if x != nil {
-		return x.HolderName
-	}
-	return ""
-}
-
-func (x *CardResponse) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-func (x *CardResponse) GetCcv() string {
+func (x *TextData) GetText() string {
 	if x != nil {
-		return x.Ccv
+		return x.Text
 	}
 	return ""
 }
 
-type SaveTextRequest struct {
+// CustomData is validated against user defined template, see Templates service
+type CustomData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	TemplateId int64             `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Fields     map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SaveTextRequest) Reset() {
-	*x = SaveTextRequest{}
+func (x *CustomData) Reset() {
+	*x = CustomData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,13 +673,13 @@ func (x *SaveTextRequest) Reset() {
 	}
 }
 
-func (x *SaveTextRequest) String() string {
+func (x *CustomData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveTextRequest) ProtoMessage() {}
+func (*CustomData) ProtoMessage() {}
 
-func (x *SaveTextRequest) ProtoReflect() protoreflect.Message {
+func (x *CustomData) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,35 +691,43 @@ func (x *SaveTextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveTextRequest.ProtoReflect.Descriptor instead.
-func (*SaveTextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomData.ProtoReflect.Descriptor instead.
+func (*CustomData) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SaveTextRequest) GetName() string {
+func (x *CustomData) GetTemplateId() int64 {
 	if x != nil {
-		return x.Name
+		return x.TemplateId
 	}
-	return ""
+	return 0
 }
 
-func (x *SaveTextRequest) GetText() string {
+func (x *CustomData) GetFields() map[string]string {
 	if x != nil {
-		return x.Text
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-type TextResponse struct {
+// TOTPData is RFC 6238 seed. Unset algorithm, digits and period default to SHA1, 6 and 30
+type TOTPData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// base32 encoded seed
+	Secret    string        `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algorithm TOTPAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=TOTPAlgorithm" json:"algorithm,omitempty"`
+	Digits    int32         `protobuf:"varint,3,opt,name=digits,proto3" json:"digits,omitempty"`
+	// period in seconds
+	Period      int32  `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	Issuer      string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AccountName string `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 }
 
-func (x *TextResponse) Reset() {
-	*x = TextResponse{}
+func (x *TOTPData) Reset() {
+	*x = TOTPData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_proto_secrets_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,13 +735,13 @@ func (x *TextResponse) Reset() {
 	}
 }
 
-func (x *TextResponse) String() string {
+func (x *TOTPData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextResponse) ProtoMessage() {}
+func (*TOTPData) ProtoMessage() {}
 
-func (x *TextResponse) ProtoReflect() protoreflect.Message {
+func (x *TOTPData) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_proto_secrets_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,130 +753,5294 @@ func (x *TextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextResponse.ProtoReflect.Descriptor instead.
-func (*TextResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TOTPData.ProtoReflect.Descriptor instead.
+func (*TOTPData) Descriptor() ([]byte, []int) {
 	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TextResponse) GetText() string {
+func (x *TOTPData) GetSecret() string {
 	if x != nil {
-		return x.Text
+		return x.Secret
 	}
 	return ""
 }
 
-var File_internal_app_proto_secrets_proto protoreflect.FileDescriptor
+func (x *TOTPData) GetAlgorithm() TOTPAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return TOTPAlgorithm_TOTP_ALGORITHM_UNSPECIFIED
+}
 
-var file_internal_app_proto_secrets_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x44, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x43,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
-	0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
-	0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
-	0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x63, 0x63, 0x76, 0x22, 0x6c, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
-	0x63, 0x76, 0x22, 0x39, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x22, 0x0a,
-	0x0c, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x32, 0x92, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a,
-	0x0c, 0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x2e,
-	0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x10, 0x2e, 0x53,
-	0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
-	0x64, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x10, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x78, 0x74, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *TOTPData) GetDigits() int32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
 }
 
-var (
-	file_internal_app_proto_secrets_proto_rawDescOnce sync.Once
-	file_internal_app_proto_secrets_proto_rawDescData = file_internal_app_proto_secrets_proto_rawDesc
-)
+func (x *TOTPData) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
 
-func file_internal_app_proto_secrets_proto_rawDescGZIP() []byte {
-	file_internal_app_proto_secrets_proto_rawDescOnce.Do(func() {
-		file_internal_app_proto_secrets_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_app_proto_secrets_proto_rawDescData)
-	})
-	return file_internal_app_proto_secrets_proto_rawDescData
+func (x *TOTPData) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
 }
 
-var file_internal_app_proto_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_internal_app_proto_secrets_proto_goTypes = []interface{}{
-	(*Empty)(nil),               // 0: Empty
-	(*GetSecretRequest)(nil),    // 1: GetSecretRequest
-	(*SavePasswordRequest)(nil), // 2: SavePasswordRequest
-	(*PasswordResponse)(nil),    // 3: PasswordResponse
-	(*SaveCardRequest)(nil),     // 4: SaveCardRequest
-	(*CardResponse)(nil),        // 5: CardResponse
-	(*SaveTextRequest)(nil),     // 6: SaveTextRequest
-	(*TextResponse)(nil),        // 7: TextResponse
+func (x *TOTPData) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
-var file_internal_app_proto_secrets_proto_depIdxs = []int32{
-	2, // 0: Secrets.SavePassword:input_type -> SavePasswordRequest
-	1, // 1: Secrets.GetPassword:input_type -> GetSecretRequest
-	4, // 2: Secrets.SaveCard:input_type -> SaveCardRequest
-	1, // 3: Secrets.GetCard:input_type -> GetSecretRequest
-	6, // 4: Secrets.SaveText:input_type -> SaveTextRequest
-	1, // 5: Secrets.GetText:input_type -> GetSecretRequest
-	0, // 6: Secrets.SavePassword:output_type -> Empty
-	3, // 7: Secrets.GetPassword:output_type -> PasswordResponse
-	0, // 8: Secrets.SaveCard:output_type -> Empty
-	5, // 9: Secrets.GetCard:output_type -> CardResponse
-	0, // 10: Secrets.SaveText:output_type -> Empty
-	7, // 11: Secrets.GetText:output_type -> TextResponse
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// SSHKeyData is SSH private key. Public part is derived from the private key by server
+// and is also available in ssh.* labels of the secret
+type SSHKeyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM encoded private key, ed25519, rsa and ecdsa keys are supported
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// passphrase is required to save protected keys that are not in OpenSSH format
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Comment    string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// following fields are ignored on save
+	PublicKey   string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Fingerprint string `protobuf:"bytes,5,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	KeyType     string `protobuf:"bytes,6,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
 }
 
-func init() { file_internal_app_proto_secrets_proto_init() }
-func file_internal_app_proto_secrets_proto_init() {
-	if File_internal_app_proto_secrets_proto != nil {
-		return
+func (x *SSHKeyData) Reset() {
+	*x = SSHKeyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_app_proto_secrets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+}
+
+func (x *SSHKeyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyData) ProtoMessage() {}
+
+func (x *SSHKeyData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyData.ProtoReflect.Descriptor instead.
+func (*SSHKeyData) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SSHKeyData) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyData) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *SSHKeyData) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SSHKeyData) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyData) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SSHKeyData) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+type SecretPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*SecretPayload_Password
+	//	*SecretPayload_Card
+	//	*SecretPayload_Text
+	//	*SecretPayload_File
+	//	*SecretPayload_Custom
+	//	*SecretPayload_Totp
+	//	*SecretPayload_SshKey
+	//	*SecretPayload_Encrypted
+	Payload isSecretPayload_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *SecretPayload) Reset() {
+	*x = SecretPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretPayload) ProtoMessage() {}
+
+func (x *SecretPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretPayload.ProtoReflect.Descriptor instead.
+func (*SecretPayload) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *SecretPayload) GetPayload() isSecretPayload_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetPassword() *PasswordData {
+	if x, ok := x.GetPayload().(*SecretPayload_Password); ok {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetCard() *CardData {
+	if x, ok := x.GetPayload().(*SecretPayload_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetText() *TextData {
+	if x, ok := x.GetPayload().(*SecretPayload_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetFile() *FileInfo {
+	if x, ok := x.GetPayload().(*SecretPayload_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetCustom() *CustomData {
+	if x, ok := x.GetPayload().(*SecretPayload_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetTotp() *TOTPData {
+	if x, ok := x.GetPayload().(*SecretPayload_Totp); ok {
+		return x.Totp
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetSshKey() *SSHKeyData {
+	if x, ok := x.GetPayload().(*SecretPayload_SshKey); ok {
+		return x.SshKey
+	}
+	return nil
+}
+
+func (x *SecretPayload) GetEncrypted() []byte {
+	if x, ok := x.GetPayload().(*SecretPayload_Encrypted); ok {
+		return x.Encrypted
+	}
+	return nil
+}
+
+type isSecretPayload_Payload interface {
+	isSecretPayload_Payload()
+}
+
+type SecretPayload_Password struct {
+	Password *PasswordData `protobuf:"bytes,1,opt,name=password,proto3,oneof"`
+}
+
+type SecretPayload_Card struct {
+	Card *CardData `protobuf:"bytes,2,opt,name=card,proto3,oneof"`
+}
+
+type SecretPayload_Text struct {
+	Text *TextData `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
+}
+
+type SecretPayload_File struct {
+	// file is returned by GetSecret only, use UploadFile to save files
+	File *FileInfo `protobuf:"bytes,4,opt,name=file,proto3,oneof"`
+}
+
+type SecretPayload_Custom struct {
+	Custom *CustomData `protobuf:"bytes,5,opt,name=custom,proto3,oneof"`
+}
+
+type SecretPayload_Totp struct {
+	Totp *TOTPData `protobuf:"bytes,6,opt,name=totp,proto3,oneof"`
+}
+
+type SecretPayload_SshKey struct {
+	SshKey *SSHKeyData `protobuf:"bytes,7,opt,name=ssh_key,json=sshKey,proto3,oneof"`
+}
+
+type SecretPayload_Encrypted struct {
+	// encrypted is a payload of any type that is serialized and encrypted by the client with its vault key
+	Encrypted []byte `protobuf:"bytes,8,opt,name=encrypted,proto3,oneof"`
+}
+
+func (*SecretPayload_Password) isSecretPayload_Payload() {}
+
+func (*SecretPayload_Card) isSecretPayload_Payload() {}
+
+func (*SecretPayload_Text) isSecretPayload_Payload() {}
+
+func (*SecretPayload_File) isSecretPayload_Payload() {}
+
+func (*SecretPayload_Custom) isSecretPayload_Payload() {}
+
+func (*SecretPayload_Totp) isSecretPayload_Payload() {}
+
+func (*SecretPayload_SshKey) isSecretPayload_Payload() {}
+
+func (*SecretPayload_Encrypted) isSecretPayload_Payload() {}
+
+type PutSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Payload *SecretPayload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// version must be 0 for new secrets and equal to the current version for existing ones
+	Version    int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// shared_id is id of the secret shared with the user with read-write permission, name is ignored if it is set
+	SharedId int64 `protobuf:"varint,5,opt,name=shared_id,json=sharedId,proto3" json:"shared_id,omitempty"`
+	// type is required for encrypted payloads, otherwise it is taken from the payload
+	Type SecretType `protobuf:"varint,6,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+}
+
+func (x *PutSecretRequest) Reset() {
+	*x = PutSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSecretRequest) ProtoMessage() {}
+
+func (x *PutSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSecretRequest.ProtoReflect.Descriptor instead.
+func (*PutSecretRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PutSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutSecretRequest) GetPayload() *SecretPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PutSecretRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PutSecretRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PutSecretRequest) GetSharedId() int64 {
+	if x != nil {
+		return x.SharedId
+	}
+	return 0
+}
+
+func (x *PutSecretRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+type PutSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// strength is set for password secrets
+	Strength *PasswordStrength `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	// breach is set for password secrets if server has corpus of breached passwords
+	Breach *BreachCheck `protobuf:"bytes,3,opt,name=breach,proto3" json:"breach,omitempty"`
+}
+
+func (x *PutSecretResponse) Reset() {
+	*x = PutSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSecretResponse) ProtoMessage() {}
+
+func (x *PutSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSecretResponse.ProtoReflect.Descriptor instead.
+func (*PutSecretResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PutSecretResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PutSecretResponse) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+func (x *PutSecretResponse) GetBreach() *BreachCheck {
+	if x != nil {
+		return x.Breach
+	}
+	return nil
+}
+
+type SecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *SecretMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Payload  *SecretPayload  `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *SecretResponse) Reset() {
+	*x = SecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretResponse) ProtoMessage() {}
+
+func (x *SecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
+func (*SecretResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SecretResponse) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SecretResponse) GetPayload() *SecretPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// SecretAttributes are not encrypted and can be used to filter secrets
+// Tags and labels are replaced as a whole, expiry date and rotation period are changed only if they are set
+type SecretAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags      []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels    map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// secret needs rotation when it was not updated for this number of days, zero removes rotation
+	RotateEveryDays *int32 `protobuf:"varint,4,opt,name=rotate_every_days,json=rotateEveryDays,proto3,oneof" json:"rotate_every_days,omitempty"`
+	// clear_expires_at removes expiry date of the secret, expires_at is ignored then
+	ClearExpiresAt bool `protobuf:"varint,5,opt,name=clear_expires_at,json=clearExpiresAt,proto3" json:"clear_expires_at,omitempty"`
+}
+
+func (x *SecretAttributes) Reset() {
+	*x = SecretAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAttributes) ProtoMessage() {}
+
+func (x *SecretAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAttributes.ProtoReflect.Descriptor instead.
+func (*SecretAttributes) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SecretAttributes) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SecretAttributes) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SecretAttributes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SecretAttributes) GetRotateEveryDays() int32 {
+	if x != nil && x.RotateEveryDays != nil {
+		return *x.RotateEveryDays
+	}
+	return 0
+}
+
+func (x *SecretAttributes) GetClearExpiresAt() bool {
+	if x != nil {
+		return x.ClearExpiresAt
+	}
+	return false
+}
+
+type SavePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Login      string            `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password   string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Urls       []*PasswordURL    `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *SavePasswordRequest) Reset() {
+	*x = SavePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePasswordRequest) ProtoMessage() {}
+
+func (x *SavePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePasswordRequest.ProtoReflect.Descriptor instead.
+func (*SavePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SavePasswordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavePasswordRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SavePasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SavePasswordRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SavePasswordRequest) GetUrls() []*PasswordURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type PasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string         `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string         `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Version  int64          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Urls     []*PasswordURL `protobuf:"bytes,4,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *PasswordResponse) Reset() {
+	*x = PasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResponse) ProtoMessage() {}
+
+func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PasswordResponse) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PasswordResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PasswordResponse) GetUrls() []*PasswordURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type SaveCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardName   string            `protobuf:"bytes,1,opt,name=cardName,proto3" json:"cardName,omitempty"`
+	Number     string            `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	HolderName string            `protobuf:"bytes,3,opt,name=holderName,proto3" json:"holderName,omitempty"`
+	Date       string            `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Ccv        string            `protobuf:"bytes,5,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SaveCardRequest) Reset() {
+	*x = SaveCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCardRequest) ProtoMessage() {}
+
+func (x *SaveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCardRequest.ProtoReflect.Descriptor instead.
+func (*SaveCardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SaveCardRequest) GetCardName() string {
+	if x != nil {
+		return x.CardName
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetCcv() string {
+	if x != nil {
+		return x.Ccv
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number is masked and ccv is empty unless card is revealed
+	Number     string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	HolderName string `protobuf:"bytes,2,opt,name=holderName,proto3" json:"holderName,omitempty"`
+	Date       string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Ccv        string `protobuf:"bytes,4,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	Version    int64  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Brand      string `protobuf:"bytes,6,opt,name=brand,proto3" json:"brand,omitempty"`
+	Last4      string `protobuf:"bytes,7,opt,name=last4,proto3" json:"last4,omitempty"`
+}
+
+func (x *CardResponse) Reset() {
+	*x = CardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardResponse) ProtoMessage() {}
+
+func (x *CardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardResponse.ProtoReflect.Descriptor instead.
+func (*CardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CardResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CardResponse) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+func (x *CardResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CardResponse) GetCcv() string {
+	if x != nil {
+		return x.Ccv
+	}
+	return ""
+}
+
+func (x *CardResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CardResponse) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CardResponse) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+type SaveTextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text       string            `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SaveTextRequest) Reset() {
+	*x = SaveTextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTextRequest) ProtoMessage() {}
+
+func (x *SaveTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTextRequest.ProtoReflect.Descriptor instead.
+func (*SaveTextRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SaveTextRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveTextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SaveTextRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type TextResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *TextResponse) Reset() {
+	*x = TextResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextResponse) ProtoMessage() {}
+
+func (x *TextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextResponse.ProtoReflect.Descriptor instead.
+func (*TextResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TextResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// version in update requests must be equal to the version of the secret
+// that was read by the client, otherwise update fails with FAILED_PRECONDITION.
+// Attributes are replaced only if they are set in update request
+type UpdatePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Login      string            `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password   string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Version    int64             `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Urls       []*PasswordURL    `protobuf:"bytes,6,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *UpdatePasswordRequest) Reset() {
+	*x = UpdatePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePasswordRequest) ProtoMessage() {}
+
+func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePasswordRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePasswordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePasswordRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *UpdatePasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdatePasswordRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdatePasswordRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdatePasswordRequest) GetUrls() []*PasswordURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type UpdateCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardName   string            `protobuf:"bytes,1,opt,name=cardName,proto3" json:"cardName,omitempty"`
+	Number     string            `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	HolderName string            `protobuf:"bytes,3,opt,name=holderName,proto3" json:"holderName,omitempty"`
+	Date       string            `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Ccv        string            `protobuf:"bytes,5,opt,name=ccv,proto3" json:"ccv,omitempty"`
+	Version    int64             `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *UpdateCardRequest) Reset() {
+	*x = UpdateCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardRequest) ProtoMessage() {}
+
+func (x *UpdateCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCardRequest) GetCardName() string {
+	if x != nil {
+		return x.CardName
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetCcv() string {
+	if x != nil {
+		return x.Ccv
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateCardRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateTextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text       string            `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Version    int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *UpdateTextRequest) Reset() {
+	*x = UpdateTextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTextRequest) ProtoMessage() {}
+
+func (x *UpdateTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTextRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTextRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateTextRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateTextRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTextRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// strength is set for password secrets
+	Strength *PasswordStrength `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	// breach is set for password secrets if server has corpus of breached passwords
+	Breach *BreachCheck `protobuf:"bytes,3,opt,name=breach,proto3" json:"breach,omitempty"`
+}
+
+func (x *UpdateSecretResponse) Reset() {
+	*x = UpdateSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretResponse) ProtoMessage() {}
+
+func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateSecretResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateSecretResponse) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+func (x *UpdateSecretResponse) GetBreach() *BreachCheck {
+	if x != nil {
+		return x.Breach
+	}
+	return nil
+}
+
+type SecretMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       SecretType             `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id         int64                  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version    int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes *SecretAttributes      `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// client_encrypted secrets are encrypted by the client, server can't read them
+	ClientEncrypted bool `protobuf:"varint,9,opt,name=client_encrypted,json=clientEncrypted,proto3" json:"client_encrypted,omitempty"`
+}
+
+func (x *SecretMetadata) Reset() {
+	*x = SecretMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretMetadata) ProtoMessage() {}
+
+func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretMetadata.ProtoReflect.Descriptor instead.
+func (*SecretMetadata) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SecretMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *SecretMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetClientEncrypted() bool {
+	if x != nil {
+		return x.ClientEncrypted
+	}
+	return false
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type filters secrets by type, SECRET_TYPE_UNSPECIFIED returns all types
+	Type       SecretType `protobuf:"varint,1,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	NamePrefix string     `protobuf:"bytes,2,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// cursor is the next_cursor value from the previous page, 0 for the first page
+	Cursor int64 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// only secrets that have all of the tags and labels are returned
+	Attributes *SecretAttributes `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSecretsRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *ListSecretsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets []*SecretMetadata `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// next_cursor is 0 when there are no more pages
+	NextCursor int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*SecretMetadata {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListSecretsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTrashRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TrashedSecretRequest identifies deleted secret by id,
+// because trash may contain several secrets with the same name
+type TrashedSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TrashedSecretRequest) Reset() {
+	*x = TrashedSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashedSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedSecretRequest) ProtoMessage() {}
+
+func (x *TrashedSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedSecretRequest.ProtoReflect.Descriptor instead.
+func (*TrashedSecretRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrashedSecretRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	// shared_id is id of the secret shared with the user, name is ignored if it is set
+	SharedId int64 `protobuf:"varint,3,opt,name=shared_id,json=sharedId,proto3" json:"shared_id,omitempty"`
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *ListRevisionsRequest) GetSharedId() int64 {
+	if x != nil {
+		return x.SharedId
+	}
+	return 0
+}
+
+type Revision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Revision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// revisions are sorted from newest to oldest
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	Version int64      `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// shared_id is id of the secret shared with the user, name is ignored if it is set.
+	// Revisions of shared secret are restored only with read-write permission
+	SharedId int64 `protobuf:"varint,4,opt,name=shared_id,json=sharedId,proto3" json:"shared_id,omitempty"`
+}
+
+func (x *RevisionRequest) Reset() {
+	*x = RevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionRequest) ProtoMessage() {}
+
+func (x *RevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionRequest.ProtoReflect.Descriptor instead.
+func (*RevisionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevisionRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *RevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RevisionRequest) GetSharedId() int64 {
+	if x != nil {
+		return x.SharedId
+	}
+	return 0
+}
+
+type RevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Types that are assignable to Secret:
+	//	*RevisionResponse_Password
+	//	*RevisionResponse_Card
+	//	*RevisionResponse_Text
+	//	*RevisionResponse_Encrypted
+	Secret isRevisionResponse_Secret `protobuf_oneof:"secret"`
+}
+
+func (x *RevisionResponse) Reset() {
+	*x = RevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionResponse) ProtoMessage() {}
+
+func (x *RevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionResponse.ProtoReflect.Descriptor instead.
+func (*RevisionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevisionResponse) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (m *RevisionResponse) GetSecret() isRevisionResponse_Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (x *RevisionResponse) GetPassword() *PasswordResponse {
+	if x, ok := x.GetSecret().(*RevisionResponse_Password); ok {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *RevisionResponse) GetCard() *CardResponse {
+	if x, ok := x.GetSecret().(*RevisionResponse_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *RevisionResponse) GetText() *TextResponse {
+	if x, ok := x.GetSecret().(*RevisionResponse_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *RevisionResponse) GetEncrypted() []byte {
+	if x, ok := x.GetSecret().(*RevisionResponse_Encrypted); ok {
+		return x.Encrypted
+	}
+	return nil
+}
+
+type isRevisionResponse_Secret interface {
+	isRevisionResponse_Secret()
+}
+
+type RevisionResponse_Password struct {
+	Password *PasswordResponse `protobuf:"bytes,2,opt,name=password,proto3,oneof"`
+}
+
+type RevisionResponse_Card struct {
+	Card *CardResponse `protobuf:"bytes,3,opt,name=card,proto3,oneof"`
+}
+
+type RevisionResponse_Text struct {
+	Text *TextResponse `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type RevisionResponse_Encrypted struct {
+	// encrypted is set if the revision is encrypted by the client
+	Encrypted []byte `protobuf:"bytes,5,opt,name=encrypted,proto3,oneof"`
+}
+
+func (*RevisionResponse_Password) isRevisionResponse_Secret() {}
+
+func (*RevisionResponse_Card) isRevisionResponse_Secret() {}
+
+func (*RevisionResponse_Text) isRevisionResponse_Secret() {}
+
+func (*RevisionResponse_Encrypted) isRevisionResponse_Secret() {}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the secret
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Size     int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// checksum is hex encoded SHA-256 of file content
+	Checksum   string            `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// client_encrypted is set for files that are encrypted by the client in zero-knowledge mode.
+	// Their chunks are stored as they are sent and file_name, size and checksum are sent in encrypted_info
+	ClientEncrypted bool `protobuf:"varint,6,opt,name=client_encrypted,json=clientEncrypted,proto3" json:"client_encrypted,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *FileInfo) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *FileInfo) GetClientEncrypted() bool {
+	if x != nil {
+		return x.ClientEncrypted
+	}
+	return false
+}
+
+type UploadFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadFileRequest_Info
+	//	*UploadFileRequest_Chunk
+	//	*UploadFileRequest_EncryptedInfo
+	Data isUploadFileRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest) ProtoMessage() {}
+
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{36}
+}
+
+func (m *UploadFileRequest) GetData() isUploadFileRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetInfo() *FileInfo {
+	if x, ok := x.GetData().(*UploadFileRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadFileRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetEncryptedInfo() []byte {
+	if x, ok := x.GetData().(*UploadFileRequest_EncryptedInfo); ok {
+		return x.EncryptedInfo
+	}
+	return nil
+}
+
+type isUploadFileRequest_Data interface {
+	isUploadFileRequest_Data()
+}
+
+type UploadFileRequest_Info struct {
+	Info *FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadFileRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type UploadFileRequest_EncryptedInfo struct {
+	// encrypted_info is the last message of client-encrypted upload, it is FileInfo encrypted by the client
+	EncryptedInfo []byte `protobuf:"bytes,3,opt,name=encrypted_info,json=encryptedInfo,proto3,oneof"`
+}
+
+func (*UploadFileRequest_Info) isUploadFileRequest_Data() {}
+
+func (*UploadFileRequest_Chunk) isUploadFileRequest_Data() {}
+
+func (*UploadFileRequest_EncryptedInfo) isUploadFileRequest_Data() {}
+
+type DownloadFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*DownloadFileResponse_Info
+	//	*DownloadFileResponse_Chunk
+	//	*DownloadFileResponse_EncryptedInfo
+	Data isDownloadFileResponse_Data `protobuf_oneof:"data"`
+}
+
+func (x *DownloadFileResponse) Reset() {
+	*x = DownloadFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileResponse) ProtoMessage() {}
+
+func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *DownloadFileResponse) GetData() isDownloadFileResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetInfo() *FileInfo {
+	if x, ok := x.GetData().(*DownloadFileResponse_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetChunk() []byte {
+	if x, ok := x.GetData().(*DownloadFileResponse_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetEncryptedInfo() []byte {
+	if x, ok := x.GetData().(*DownloadFileResponse_EncryptedInfo); ok {
+		return x.EncryptedInfo
+	}
+	return nil
+}
+
+type isDownloadFileResponse_Data interface {
+	isDownloadFileResponse_Data()
+}
+
+type DownloadFileResponse_Info struct {
+	Info *FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadFileResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type DownloadFileResponse_EncryptedInfo struct {
+	// encrypted_info is sent instead of info for client-encrypted files
+	EncryptedInfo []byte `protobuf:"bytes,3,opt,name=encrypted_info,json=encryptedInfo,proto3,oneof"`
+}
+
+func (*DownloadFileResponse_Info) isDownloadFileResponse_Data() {}
+
+func (*DownloadFileResponse_Chunk) isDownloadFileResponse_Data() {}
+
+func (*DownloadFileResponse_EncryptedInfo) isDownloadFileResponse_Data() {}
+
+type ImportTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uri        string            `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Attributes *SecretAttributes `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ImportTOTPRequest) Reset() {
+	*x = ImportTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTOTPRequest) ProtoMessage() {}
+
+func (x *ImportTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ImportTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportTOTPRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportTOTPRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ImportTOTPRequest) GetAttributes() *SecretAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetTOTPCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetTOTPCodeRequest) Reset() {
+	*x = GetTOTPCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTOTPCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTOTPCodeRequest) ProtoMessage() {}
+
+func (x *GetTOTPCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTOTPCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetTOTPCodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTOTPCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TOTPCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code             string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	SecondsRemaining int32  `protobuf:"varint,2,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
+}
+
+func (x *TOTPCodeResponse) Reset() {
+	*x = TOTPCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TOTPCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPCodeResponse) ProtoMessage() {}
+
+func (x *TOTPCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPCodeResponse.ProtoReflect.Descriptor instead.
+func (*TOTPCodeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TOTPCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TOTPCodeResponse) GetSecondsRemaining() int32 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
+// zero values of SecurityReportRequest mean 180 days, 30 days and score 2 (fair)
+type SecurityReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// passwords unchanged for more days are reported as old
+	MaxPasswordAgeDays int32 `protobuf:"varint,1,opt,name=max_password_age_days,json=maxPasswordAgeDays,proto3" json:"max_password_age_days,omitempty"`
+	// cards expiring in less days are reported as expiring soon
+	CardExpiryWarningDays int32 `protobuf:"varint,2,opt,name=card_expiry_warning_days,json=cardExpiryWarningDays,proto3" json:"card_expiry_warning_days,omitempty"`
+	// passwords with lower score are reported as weak
+	MinScore int32 `protobuf:"varint,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+}
+
+func (x *SecurityReportRequest) Reset() {
+	*x = SecurityReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityReportRequest) ProtoMessage() {}
+
+func (x *SecurityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityReportRequest.ProtoReflect.Descriptor instead.
+func (*SecurityReportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SecurityReportRequest) GetMaxPasswordAgeDays() int32 {
+	if x != nil {
+		return x.MaxPasswordAgeDays
+	}
+	return 0
+}
+
+func (x *SecurityReportRequest) GetCardExpiryWarningDays() int32 {
+	if x != nil {
+		return x.CardExpiryWarningDays
+	}
+	return 0
+}
+
+func (x *SecurityReportRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+type ReusedPasswords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ReusedPasswords) Reset() {
+	*x = ReusedPasswords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReusedPasswords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusedPasswords) ProtoMessage() {}
+
+func (x *ReusedPasswords) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusedPasswords.ProtoReflect.Descriptor instead.
+func (*ReusedPasswords) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReusedPasswords) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type WeakPasswordReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Strength *PasswordStrength `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+}
+
+func (x *WeakPasswordReport) Reset() {
+	*x = WeakPasswordReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeakPasswordReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeakPasswordReport) ProtoMessage() {}
+
+func (x *WeakPasswordReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeakPasswordReport.ProtoReflect.Descriptor instead.
+func (*WeakPasswordReport) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WeakPasswordReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WeakPasswordReport) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+type OldPasswordReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *OldPasswordReport) Reset() {
+	*x = OldPasswordReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OldPasswordReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OldPasswordReport) ProtoMessage() {}
+
+func (x *OldPasswordReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OldPasswordReport.ProtoReflect.Descriptor instead.
+func (*OldPasswordReport) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OldPasswordReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OldPasswordReport) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ExpiringCardReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Last4 string `protobuf:"bytes,2,opt,name=last4,proto3" json:"last4,omitempty"`
+	// end of expiry month of the card
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ExpiringCardReport) Reset() {
+	*x = ExpiringCardReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiringCardReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringCardReport) ProtoMessage() {}
+
+func (x *ExpiringCardReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringCardReport.ProtoReflect.Descriptor instead.
+func (*ExpiringCardReport) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExpiringCardReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpiringCardReport) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *ExpiringCardReport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SecurityReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReusedPasswords []*ReusedPasswords    `protobuf:"bytes,1,rep,name=reused_passwords,json=reusedPasswords,proto3" json:"reused_passwords,omitempty"`
+	WeakPasswords   []*WeakPasswordReport `protobuf:"bytes,2,rep,name=weak_passwords,json=weakPasswords,proto3" json:"weak_passwords,omitempty"`
+	OldPasswords    []*OldPasswordReport  `protobuf:"bytes,3,rep,name=old_passwords,json=oldPasswords,proto3" json:"old_passwords,omitempty"`
+	ExpiredCards    []*ExpiringCardReport `protobuf:"bytes,4,rep,name=expired_cards,json=expiredCards,proto3" json:"expired_cards,omitempty"`
+	ExpiringCards   []*ExpiringCardReport `protobuf:"bytes,5,rep,name=expiring_cards,json=expiringCards,proto3" json:"expiring_cards,omitempty"`
+}
+
+func (x *SecurityReportResponse) Reset() {
+	*x = SecurityReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityReportResponse) ProtoMessage() {}
+
+func (x *SecurityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityReportResponse.ProtoReflect.Descriptor instead.
+func (*SecurityReportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SecurityReportResponse) GetReusedPasswords() []*ReusedPasswords {
+	if x != nil {
+		return x.ReusedPasswords
+	}
+	return nil
+}
+
+func (x *SecurityReportResponse) GetWeakPasswords() []*WeakPasswordReport {
+	if x != nil {
+		return x.WeakPasswords
+	}
+	return nil
+}
+
+func (x *SecurityReportResponse) GetOldPasswords() []*OldPasswordReport {
+	if x != nil {
+		return x.OldPasswords
+	}
+	return nil
+}
+
+func (x *SecurityReportResponse) GetExpiredCards() []*ExpiringCardReport {
+	if x != nil {
+		return x.ExpiredCards
+	}
+	return nil
+}
+
+func (x *SecurityReportResponse) GetExpiringCards() []*ExpiringCardReport {
+	if x != nil {
+		return x.ExpiringCards
+	}
+	return nil
+}
+
+type ListExpiringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// zero returns only overdue secrets
+	WithinDays int32 `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+}
+
+func (x *ListExpiringRequest) Reset() {
+	*x = ListExpiringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExpiringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringRequest) ProtoMessage() {}
+
+func (x *ListExpiringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListExpiringRequest) GetWithinDays() int32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+type DueSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *SecretMetadata        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DueAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Reason   DueReason              `protobuf:"varint,3,opt,name=reason,proto3,enum=DueReason" json:"reason,omitempty"`
+}
+
+func (x *DueSecret) Reset() {
+	*x = DueSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DueSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueSecret) ProtoMessage() {}
+
+func (x *DueSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueSecret.ProtoReflect.Descriptor instead.
+func (*DueSecret) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DueSecret) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DueSecret) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *DueSecret) GetReason() DueReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DueReason_DUE_REASON_UNSPECIFIED
+}
+
+type ListExpiringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets []*DueSecret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+}
+
+func (x *ListExpiringResponse) Reset() {
+	*x = ListExpiringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExpiringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringResponse) ProtoMessage() {}
+
+func (x *ListExpiringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListExpiringResponse) GetSecrets() []*DueSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type FindByURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *FindByURLRequest) Reset() {
+	*x = FindByURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByURLRequest) ProtoMessage() {}
+
+func (x *FindByURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByURLRequest.ProtoReflect.Descriptor instead.
+func (*FindByURLRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FindByURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type Credential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *SecretMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Password *PasswordData   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
+func (*Credential) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Credential) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Credential) GetPassword() *PasswordData {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+type FindByURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials []*Credential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *FindByURLResponse) Reset() {
+	*x = FindByURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByURLResponse) ProtoMessage() {}
+
+func (x *FindByURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByURLResponse.ProtoReflect.Descriptor instead.
+func (*FindByURLResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FindByURLResponse) GetCredentials() []*Credential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type ShareSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	// username of the user the secret is shared with
+	Username   string          `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission SharePermission `protobuf:"varint,4,opt,name=permission,proto3,enum=SharePermission" json:"permission,omitempty"`
+}
+
+func (x *ShareSecretRequest) Reset() {
+	*x = ShareSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareSecretRequest) ProtoMessage() {}
+
+func (x *ShareSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareSecretRequest.ProtoReflect.Descriptor instead.
+func (*ShareSecretRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ShareSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShareSecretRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *ShareSecretRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ShareSecretRequest) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+type RevokeShareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	Username string     `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *RevokeShareRequest) Reset() {
+	*x = RevokeShareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareRequest) ProtoMessage() {}
+
+func (x *RevokeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RevokeShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevokeShareRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *RevokeShareRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+}
+
+func (x *ListSharesRequest) Reset() {
+	*x = ListSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesRequest) ProtoMessage() {}
+
+func (x *ListSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSharesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListSharesRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+type Share struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Permission SharePermission        `protobuf:"varint,2,opt,name=permission,proto3,enum=SharePermission" json:"permission,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Share) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Share) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+func (x *Share) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*Share `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *ListSharesResponse) Reset() {
+	*x = ListSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesResponse) ProtoMessage() {}
+
+func (x *ListSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListSharesResponse) GetShares() []*Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type SharedSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// metadata.id is the shared_id of the secret
+	Metadata *SecretMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// owner is username of the user who shared the secret
+	Owner      string          `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Permission SharePermission `protobuf:"varint,3,opt,name=permission,proto3,enum=SharePermission" json:"permission,omitempty"`
+}
+
+func (x *SharedSecret) Reset() {
+	*x = SharedSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedSecret) ProtoMessage() {}
+
+func (x *SharedSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedSecret.ProtoReflect.Descriptor instead.
+func (*SharedSecret) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SharedSecret) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SharedSecret) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SharedSecret) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+type ListSharedWithMeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets []*SharedSecret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+}
+
+func (x *ListSharedWithMeResponse) Reset() {
+	*x = ListSharedWithMeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedWithMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeResponse) ProtoMessage() {}
+
+func (x *ListSharedWithMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListSharedWithMeResponse) GetSecrets() []*SharedSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type CreateShareLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretType `protobuf:"varint,2,opt,name=type,proto3,enum=SecretType" json:"type,omitempty"`
+	// max_redemptions is how many times the link can be redeemed, 1 by default
+	MaxRedemptions int32 `protobuf:"varint,3,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	// ttl_hours is how long the link can be redeemed, 24 hours by default
+	TtlHours int32 `protobuf:"varint,4,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"`
+	// shared_id is id of the secret shared with the user with read-write permission, name is ignored if it is set
+	SharedId int64 `protobuf:"varint,5,opt,name=shared_id,json=sharedId,proto3" json:"shared_id,omitempty"`
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateShareLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return SecretType_SECRET_TYPE_UNSPECIFIED
+}
+
+func (x *CreateShareLinkRequest) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreateShareLinkRequest) GetTtlHours() int32 {
+	if x != nil {
+		return x.TtlHours
+	}
+	return 0
+}
+
+func (x *CreateShareLinkRequest) GetSharedId() int64 {
+	if x != nil {
+		return x.SharedId
+	}
+	return 0
+}
+
+type ShareLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	MaxRedemptions int32                  `protobuf:"varint,2,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLink) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RedeemShareLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RedeemShareLinkRequest) Reset() {
+	*x = RedeemShareLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemShareLinkRequest) ProtoMessage() {}
+
+func (x *RedeemShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RedeemShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RedeemShareLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RedeemShareLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Payload         *SecretPayload         `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	RedemptionsLeft int32                  `protobuf:"varint,3,opt,name=redemptions_left,json=redemptionsLeft,proto3" json:"redemptions_left,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *RedeemShareLinkResponse) Reset() {
+	*x = RedeemShareLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_proto_secrets_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemShareLinkResponse) ProtoMessage() {}
+
+func (x *RedeemShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_proto_secrets_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*RedeemShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_proto_secrets_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RedeemShareLinkResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RedeemShareLinkResponse) GetPayload() *SecretPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *RedeemShareLinkResponse) GetRedemptionsLeft() int32 {
+	if x != nil {
+		return x.RedemptionsLeft
+	}
+	return 0
+}
+
+func (x *RedeemShareLinkResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_internal_app_proto_secrets_proto protoreflect.FileDescriptor
+
+var file_internal_app_proto_secrets_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x64, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0b, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x55, 0x52, 0x4c, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x62, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x95, 0x01, 0x0a,
+	0x08, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x63, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x73, 0x74, 0x34, 0x22, 0x1e, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xbb, 0x01, 0x0a, 0x08, 0x54, 0x4f, 0x54, 0x50, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc3,
+	0x01, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1f, 0x0a,
+	0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x4f,
+	0x54, 0x50, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x26,
+	0x0a, 0x07, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0xdb, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24,
+	0x0a, 0x06, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x22, 0x67, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xc4, 0x02,
+	0x0a, 0x10, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x11, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x52,
+	0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x53,
+	0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x63, 0x76, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0c,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x63, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x73, 0x74, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x34,
+	0x22, 0x6c, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3c,
+	0x0a, 0x0c, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x63, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x63, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x22, 0xfe, 0x02, 0x0a, 0x0e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0xb7, 0x01, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x68, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x08, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x0f,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1e, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xc9, 0x01, 0x0a,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x0e, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6c, 0x0a, 0x11, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54,
+	0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x67, 0x65,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x52, 0x65,
+	0x75, 0x73, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x12, 0x57, 0x65, 0x61, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x62, 0x0a, 0x11,
+	0x4f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x79, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x73, 0x74, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x34,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x16,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x10, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x77, 0x65, 0x61, 0x6b, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x65,
+	0x61, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x0d, 0x77, 0x65, 0x61, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x37, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0x36,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x44, 0x75, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x64,
+	0x75, 0x65, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x44, 0x75, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x75, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x24, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x64, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x42, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x65, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x43, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x74, 0x6c, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x74, 0x74, 0x6c, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xbd, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x2a, 0xce, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45,
+	0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x43, 0x52,
+	0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x53, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x10,
+	0x07, 0x2a, 0x7f, 0x0a, 0x08, 0x55, 0x52, 0x4c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a,
+	0x15, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x52, 0x4c, 0x5f,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49,
+	0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x52, 0x4c, 0x5f, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58,
+	0x10, 0x04, 0x2a, 0x7e, 0x0a, 0x0d, 0x54, 0x4f, 0x54, 0x50, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f,
+	0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f,
+	0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x54, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x54, 0x50, 0x5f,
+	0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32,
+	0x10, 0x03, 0x2a, 0x57, 0x0a, 0x09, 0x44, 0x75, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x16, 0x44, 0x55, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44,
+	0x55, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x55, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x0f, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x48, 0x41, 0x52, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x32, 0xda, 0x0e, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x11, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c,
+	0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x53,
+	0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x10, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x10, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x15, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x28, 0x01, 0x12,
+	0x3a, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x12, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64,
+	0x42, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x11, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42,
+	0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x0b,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x12,
+	0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x2e,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x06, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x20, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x06, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_app_proto_secrets_proto_rawDescOnce sync.Once
+	file_internal_app_proto_secrets_proto_rawDescData = file_internal_app_proto_secrets_proto_rawDesc
+)
+
+func file_internal_app_proto_secrets_proto_rawDescGZIP() []byte {
+	file_internal_app_proto_secrets_proto_rawDescOnce.Do(func() {
+		file_internal_app_proto_secrets_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_app_proto_secrets_proto_rawDescData)
+	})
+	return file_internal_app_proto_secrets_proto_rawDescData
+}
+
+var file_internal_app_proto_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_internal_app_proto_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_internal_app_proto_secrets_proto_goTypes = []interface{}{
+	(SecretType)(0),                  // 0: SecretType
+	(URLMatch)(0),                    // 1: URLMatch
+	(TOTPAlgorithm)(0),               // 2: TOTPAlgorithm
+	(DueReason)(0),                   // 3: DueReason
+	(SharePermission)(0),             // 4: SharePermission
+	(*Empty)(nil),                    // 5: Empty
+	(*GetSecretRequest)(nil),         // 6: GetSecretRequest
+	(*PasswordURL)(nil),              // 7: PasswordURL
+	(*PasswordData)(nil),             // 8: PasswordData
+	(*CardData)(nil),                 // 9: CardData
+	(*TextData)(nil),                 // 10: TextData
+	(*CustomData)(nil),               // 11: CustomData
+	(*TOTPData)(nil),                 // 12: TOTPData
+	(*SSHKeyData)(nil),               // 13: SSHKeyData
+	(*SecretPayload)(nil),            // 14: SecretPayload
+	(*PutSecretRequest)(nil),         // 15: PutSecretRequest
+	(*PutSecretResponse)(nil),        // 16: PutSecretResponse
+	(*SecretResponse)(nil),           // 17: SecretResponse
+	(*SecretAttributes)(nil),         // 18: SecretAttributes
+	(*SavePasswordRequest)(nil),      // 19: SavePasswordRequest
+	(*PasswordResponse)(nil),         // 20: PasswordResponse
+	(*SaveCardRequest)(nil),          // 21: SaveCardRequest
+	(*CardResponse)(nil),             // 22: CardResponse
+	(*SaveTextRequest)(nil),          // 23: SaveTextRequest
+	(*TextResponse)(nil),             // 24: TextResponse
+	(*UpdatePasswordRequest)(nil),    // 25: UpdatePasswordRequest
+	(*UpdateCardRequest)(nil),        // 26: UpdateCardRequest
+	(*UpdateTextRequest)(nil),        // 27: UpdateTextRequest
+	(*UpdateSecretResponse)(nil),     // 28: UpdateSecretResponse
+	(*SecretMetadata)(nil),           // 29: SecretMetadata
+	(*ListSecretsRequest)(nil),       // 30: ListSecretsRequest
+	(*ListSecretsResponse)(nil),      // 31: ListSecretsResponse
+	(*DeleteSecretRequest)(nil),      // 32: DeleteSecretRequest
+	(*ListTrashRequest)(nil),         // 33: ListTrashRequest
+	(*TrashedSecretRequest)(nil),     // 34: TrashedSecretRequest
+	(*ListRevisionsRequest)(nil),     // 35: ListRevisionsRequest
+	(*Revision)(nil),                 // 36: Revision
+	(*ListRevisionsResponse)(nil),    // 37: ListRevisionsResponse
+	(*RevisionRequest)(nil),          // 38: RevisionRequest
+	(*RevisionResponse)(nil),         // 39: RevisionResponse
+	(*FileInfo)(nil),                 // 40: FileInfo
+	(*UploadFileRequest)(nil),        // 41: UploadFileRequest
+	(*DownloadFileResponse)(nil),     // 42: DownloadFileResponse
+	(*ImportTOTPRequest)(nil),        // 43: ImportTOTPRequest
+	(*GetTOTPCodeRequest)(nil),       // 44: GetTOTPCodeRequest
+	(*TOTPCodeResponse)(nil),         // 45: TOTPCodeResponse
+	(*SecurityReportRequest)(nil),    // 46: SecurityReportRequest
+	(*ReusedPasswords)(nil),          // 47: ReusedPasswords
+	(*WeakPasswordReport)(nil),       // 48: WeakPasswordReport
+	(*OldPasswordReport)(nil),        // 49: OldPasswordReport
+	(*ExpiringCardReport)(nil),       // 50: ExpiringCardReport
+	(*SecurityReportResponse)(nil),   // 51: SecurityReportResponse
+	(*ListExpiringRequest)(nil),      // 52: ListExpiringRequest
+	(*DueSecret)(nil),                // 53: DueSecret
+	(*ListExpiringResponse)(nil),     // 54: ListExpiringResponse
+	(*FindByURLRequest)(nil),         // 55: FindByURLRequest
+	(*Credential)(nil),               // 56: Credential
+	(*FindByURLResponse)(nil),        // 57: FindByURLResponse
+	(*ShareSecretRequest)(nil),       // 58: ShareSecretRequest
+	(*RevokeShareRequest)(nil),       // 59: RevokeShareRequest
+	(*ListSharesRequest)(nil),        // 60: ListSharesRequest
+	(*Share)(nil),                    // 61: Share
+	(*ListSharesResponse)(nil),       // 62: ListSharesResponse
+	(*SharedSecret)(nil),             // 63: SharedSecret
+	(*ListSharedWithMeResponse)(nil), // 64: ListSharedWithMeResponse
+	(*CreateShareLinkRequest)(nil),   // 65: CreateShareLinkRequest
+	(*ShareLink)(nil),                // 66: ShareLink
+	(*RedeemShareLinkRequest)(nil),   // 67: RedeemShareLinkRequest
+	(*RedeemShareLinkResponse)(nil),  // 68: RedeemShareLinkResponse
+	nil,                              // 69: CustomData.FieldsEntry
+	nil,                              // 70: SecretAttributes.LabelsEntry
+	(*PasswordStrength)(nil),         // 71: PasswordStrength
+	(*BreachCheck)(nil),              // 72: BreachCheck
+	(*timestamppb.Timestamp)(nil),    // 73: google.protobuf.Timestamp
+}
+var file_internal_app_proto_secrets_proto_depIdxs = []int32{
+	0,   // 0: GetSecretRequest.type:type_name -> SecretType
+	1,   // 1: PasswordURL.match:type_name -> URLMatch
+	7,   // 2: PasswordData.urls:type_name -> PasswordURL
+	69,  // 3: CustomData.fields:type_name -> CustomData.FieldsEntry
+	2,   // 4: TOTPData.algorithm:type_name -> TOTPAlgorithm
+	8,   // 5: SecretPayload.password:type_name -> PasswordData
+	9,   // 6: SecretPayload.card:type_name -> CardData
+	10,  // 7: SecretPayload.text:type_name -> TextData
+	40,  // 8: SecretPayload.file:type_name -> FileInfo
+	11,  // 9: SecretPayload.custom:type_name -> CustomData
+	12,  // 10: SecretPayload.totp:type_name -> TOTPData
+	13,  // 11: SecretPayload.ssh_key:type_name -> SSHKeyData
+	14,  // 12: PutSecretRequest.payload:type_name -> SecretPayload
+	18,  // 13: PutSecretRequest.attributes:type_name -> SecretAttributes
+	0,   // 14: PutSecretRequest.type:type_name -> SecretType
+	71,  // 15: PutSecretResponse.strength:type_name -> PasswordStrength
+	72,  // 16: PutSecretResponse.breach:type_name -> BreachCheck
+	29,  // 17: SecretResponse.metadata:type_name -> SecretMetadata
+	14,  // 18: SecretResponse.payload:type_name -> SecretPayload
+	70,  // 19: SecretAttributes.labels:type_name -> SecretAttributes.LabelsEntry
+	73,  // 20: SecretAttributes.expires_at:type_name -> google.protobuf.Timestamp
+	18,  // 21: SavePasswordRequest.attributes:type_name -> SecretAttributes
+	7,   // 22: SavePasswordRequest.urls:type_name -> PasswordURL
+	7,   // 23: PasswordResponse.urls:type_name -> PasswordURL
+	18,  // 24: SaveCardRequest.attributes:type_name -> SecretAttributes
+	18,  // 25: SaveTextRequest.attributes:type_name -> SecretAttributes
+	18,  // 26: UpdatePasswordRequest.attributes:type_name -> SecretAttributes
+	7,   // 27: UpdatePasswordRequest.urls:type_name -> PasswordURL
+	18,  // 28: UpdateCardRequest.attributes:type_name -> SecretAttributes
+	18,  // 29: UpdateTextRequest.attributes:type_name -> SecretAttributes
+	71,  // 30: UpdateSecretResponse.strength:type_name -> PasswordStrength
+	72,  // 31: UpdateSecretResponse.breach:type_name -> BreachCheck
+	0,   // 32: SecretMetadata.type:type_name -> SecretType
+	73,  // 33: SecretMetadata.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 34: SecretMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	73,  // 35: SecretMetadata.deleted_at:type_name -> google.protobuf.Timestamp
+	18,  // 36: SecretMetadata.attributes:type_name -> SecretAttributes
+	0,   // 37: ListSecretsRequest.type:type_name -> SecretType
+	18,  // 38: ListSecretsRequest.attributes:type_name -> SecretAttributes
+	29,  // 39: ListSecretsResponse.secrets:type_name -> SecretMetadata
+	0,   // 40: DeleteSecretRequest.type:type_name -> SecretType
+	0,   // 41: ListRevisionsRequest.type:type_name -> SecretType
+	73,  // 42: Revision.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 43: ListRevisionsResponse.revisions:type_name -> Revision
+	0,   // 44: RevisionRequest.type:type_name -> SecretType
+	36,  // 45: RevisionResponse.revision:type_name -> Revision
+	20,  // 46: RevisionResponse.password:type_name -> PasswordResponse
+	22,  // 47: RevisionResponse.card:type_name -> CardResponse
+	24,  // 48: RevisionResponse.text:type_name -> TextResponse
+	18,  // 49: FileInfo.attributes:type_name -> SecretAttributes
+	40,  // 50: UploadFileRequest.info:type_name -> FileInfo
+	40,  // 51: DownloadFileResponse.info:type_name -> FileInfo
+	18,  // 52: ImportTOTPRequest.attributes:type_name -> SecretAttributes
+	71,  // 53: WeakPasswordReport.strength:type_name -> PasswordStrength
+	73,  // 54: OldPasswordReport.changed_at:type_name -> google.protobuf.Timestamp
+	73,  // 55: ExpiringCardReport.expires_at:type_name -> google.protobuf.Timestamp
+	47,  // 56: SecurityReportResponse.reused_passwords:type_name -> ReusedPasswords
+	48,  // 57: SecurityReportResponse.weak_passwords:type_name -> WeakPasswordReport
+	49,  // 58: SecurityReportResponse.old_passwords:type_name -> OldPasswordReport
+	50,  // 59: SecurityReportResponse.expired_cards:type_name -> ExpiringCardReport
+	50,  // 60: SecurityReportResponse.expiring_cards:type_name -> ExpiringCardReport
+	29,  // 61: DueSecret.metadata:type_name -> SecretMetadata
+	73,  // 62: DueSecret.due_at:type_name -> google.protobuf.Timestamp
+	3,   // 63: DueSecret.reason:type_name -> DueReason
+	53,  // 64: ListExpiringResponse.secrets:type_name -> DueSecret
+	29,  // 65: Credential.metadata:type_name -> SecretMetadata
+	8,   // 66: Credential.password:type_name -> PasswordData
+	56,  // 67: FindByURLResponse.credentials:type_name -> Credential
+	0,   // 68: ShareSecretRequest.type:type_name -> SecretType
+	4,   // 69: ShareSecretRequest.permission:type_name -> SharePermission
+	0,   // 70: RevokeShareRequest.type:type_name -> SecretType
+	0,   // 71: ListSharesRequest.type:type_name -> SecretType
+	4,   // 72: Share.permission:type_name -> SharePermission
+	73,  // 73: Share.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 74: ListSharesResponse.shares:type_name -> Share
+	29,  // 75: SharedSecret.metadata:type_name -> SecretMetadata
+	4,   // 76: SharedSecret.permission:type_name -> SharePermission
+	63,  // 77: ListSharedWithMeResponse.secrets:type_name -> SharedSecret
+	0,   // 78: CreateShareLinkRequest.type:type_name -> SecretType
+	73,  // 79: ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	14,  // 80: RedeemShareLinkResponse.payload:type_name -> SecretPayload
+	73,  // 81: RedeemShareLinkResponse.expires_at:type_name -> google.protobuf.Timestamp
+	15,  // 82: Secrets.PutSecret:input_type -> PutSecretRequest
+	6,   // 83: Secrets.GetSecret:input_type -> GetSecretRequest
+	6,   // 84: Secrets.RevealSecret:input_type -> GetSecretRequest
+	19,  // 85: Secrets.SavePassword:input_type -> SavePasswordRequest
+	6,   // 86: Secrets.GetPassword:input_type -> GetSecretRequest
+	21,  // 87: Secrets.SaveCard:input_type -> SaveCardRequest
+	6,   // 88: Secrets.GetCard:input_type -> GetSecretRequest
+	6,   // 89: Secrets.RevealCard:input_type -> GetSecretRequest
+	23,  // 90: Secrets.SaveText:input_type -> SaveTextRequest
+	6,   // 91: Secrets.GetText:input_type -> GetSecretRequest
+	25,  // 92: Secrets.UpdatePassword:input_type -> UpdatePasswordRequest
+	26,  // 93: Secrets.UpdateCard:input_type -> UpdateCardRequest
+	27,  // 94: Secrets.UpdateText:input_type -> UpdateTextRequest
+	30,  // 95: Secrets.ListSecrets:input_type -> ListSecretsRequest
+	32,  // 96: Secrets.DeleteSecret:input_type -> DeleteSecretRequest
+	33,  // 97: Secrets.ListTrash:input_type -> ListTrashRequest
+	34,  // 98: Secrets.RestoreSecret:input_type -> TrashedSecretRequest
+	34,  // 99: Secrets.PurgeSecret:input_type -> TrashedSecretRequest
+	35,  // 100: Secrets.ListRevisions:input_type -> ListRevisionsRequest
+	38,  // 101: Secrets.GetRevision:input_type -> RevisionRequest
+	38,  // 102: Secrets.RestoreRevision:input_type -> RevisionRequest
+	41,  // 103: Secrets.UploadFile:input_type -> UploadFileRequest
+	6,   // 104: Secrets.DownloadFile:input_type -> GetSecretRequest
+	43,  // 105: Secrets.ImportTOTP:input_type -> ImportTOTPRequest
+	44,  // 106: Secrets.GetTOTPCode:input_type -> GetTOTPCodeRequest
+	46,  // 107: Secrets.SecurityReport:input_type -> SecurityReportRequest
+	52,  // 108: Secrets.ListExpiring:input_type -> ListExpiringRequest
+	55,  // 109: Secrets.FindByURL:input_type -> FindByURLRequest
+	58,  // 110: Secrets.ShareSecret:input_type -> ShareSecretRequest
+	59,  // 111: Secrets.RevokeShare:input_type -> RevokeShareRequest
+	60,  // 112: Secrets.ListShares:input_type -> ListSharesRequest
+	5,   // 113: Secrets.ListSharedWithMe:input_type -> Empty
+	65,  // 114: Secrets.CreateShareLink:input_type -> CreateShareLinkRequest
+	67,  // 115: Secrets.RedeemShareLink:input_type -> RedeemShareLinkRequest
+	5,   // 116: Secrets.RotateVaultKey:input_type -> Empty
+	5,   // 117: Secrets.DeleteVaultKey:input_type -> Empty
+	16,  // 118: Secrets.PutSecret:output_type -> PutSecretResponse
+	17,  // 119: Secrets.GetSecret:output_type -> SecretResponse
+	17,  // 120: Secrets.RevealSecret:output_type -> SecretResponse
+	16,  // 121: Secrets.SavePassword:output_type -> PutSecretResponse
+	20,  // 122: Secrets.GetPassword:output_type -> PasswordResponse
+	5,   // 123: Secrets.SaveCard:output_type -> Empty
+	22,  // 124: Secrets.GetCard:output_type -> CardResponse
+	22,  // 125: Secrets.RevealCard:output_type -> CardResponse
+	5,   // 126: Secrets.SaveText:output_type -> Empty
+	24,  // 127: Secrets.GetText:output_type -> TextResponse
+	28,  // 128: Secrets.UpdatePassword:output_type -> UpdateSecretResponse
+	28,  // 129: Secrets.UpdateCard:output_type -> UpdateSecretResponse
+	28,  // 130: Secrets.UpdateText:output_type -> UpdateSecretResponse
+	31,  // 131: Secrets.ListSecrets:output_type -> ListSecretsResponse
+	5,   // 132: Secrets.DeleteSecret:output_type -> Empty
+	31,  // 133: Secrets.ListTrash:output_type -> ListSecretsResponse
+	5,   // 134: Secrets.RestoreSecret:output_type -> Empty
+	5,   // 135: Secrets.PurgeSecret:output_type -> Empty
+	37,  // 136: Secrets.ListRevisions:output_type -> ListRevisionsResponse
+	39,  // 137: Secrets.GetRevision:output_type -> RevisionResponse
+	28,  // 138: Secrets.RestoreRevision:output_type -> UpdateSecretResponse
+	40,  // 139: Secrets.UploadFile:output_type -> FileInfo
+	42,  // 140: Secrets.DownloadFile:output_type -> DownloadFileResponse
+	16,  // 141: Secrets.ImportTOTP:output_type -> PutSecretResponse
+	45,  // 142: Secrets.GetTOTPCode:output_type -> TOTPCodeResponse
+	51,  // 143: Secrets.SecurityReport:output_type -> SecurityReportResponse
+	54,  // 144: Secrets.ListExpiring:output_type -> ListExpiringResponse
+	57,  // 145: Secrets.FindByURL:output_type -> FindByURLResponse
+	5,   // 146: Secrets.ShareSecret:output_type -> Empty
+	5,   // 147: Secrets.RevokeShare:output_type -> Empty
+	62,  // 148: Secrets.ListShares:output_type -> ListSharesResponse
+	64,  // 149: Secrets.ListSharedWithMe:output_type -> ListSharedWithMeResponse
+	66,  // 150: Secrets.CreateShareLink:output_type -> ShareLink
+	68,  // 151: Secrets.RedeemShareLink:output_type -> RedeemShareLinkResponse
+	5,   // 152: Secrets.RotateVaultKey:output_type -> Empty
+	5,   // 153: Secrets.DeleteVaultKey:output_type -> Empty
+	118, // [118:154] is the sub-list for method output_type
+	82,  // [82:118] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
+}
+
+func init() { file_internal_app_proto_secrets_proto_init() }
+func file_internal_app_proto_secrets_proto_init() {
+	if File_internal_app_proto_secrets_proto != nil {
+		return
+	}
+	file_internal_app_proto_passwords_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_internal_app_proto_secrets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TOTPData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHKeyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveTextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecretsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecretsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashedSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Revision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -597,8 +6051,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretRequest); i {
+		file_internal_app_proto_secrets_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -609,8 +6063,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SavePasswordRequest); i {
+		file_internal_app_proto_secrets_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -621,8 +6075,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordResponse); i {
+		file_internal_app_proto_secrets_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -633,8 +6087,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveCardRequest); i {
+		file_internal_app_proto_secrets_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -645,8 +6099,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardResponse); i {
+		file_internal_app_proto_secrets_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTOTPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -657,8 +6111,8 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveTextRequest); i {
+		file_internal_app_proto_secrets_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTOTPCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -669,8 +6123,212 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_proto_secrets_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextResponse); i {
+		file_internal_app_proto_secrets_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TOTPCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReusedPasswords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeakPasswordReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OldPasswordReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringCardReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExpiringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DueSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExpiringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Credential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeShareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Share); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -681,19 +6339,119 @@ func file_internal_app_proto_secrets_proto_init() {
 				return nil
 			}
 		}
+		file_internal_app_proto_secrets_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharedWithMeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShareLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemShareLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_proto_secrets_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemShareLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_app_proto_secrets_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*SecretPayload_Password)(nil),
+		(*SecretPayload_Card)(nil),
+		(*SecretPayload_Text)(nil),
+		(*SecretPayload_File)(nil),
+		(*SecretPayload_Custom)(nil),
+		(*SecretPayload_Totp)(nil),
+		(*SecretPayload_SshKey)(nil),
+		(*SecretPayload_Encrypted)(nil),
+	}
+	file_internal_app_proto_secrets_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_internal_app_proto_secrets_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*RevisionResponse_Password)(nil),
+		(*RevisionResponse_Card)(nil),
+		(*RevisionResponse_Text)(nil),
+		(*RevisionResponse_Encrypted)(nil),
+	}
+	file_internal_app_proto_secrets_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*UploadFileRequest_Info)(nil),
+		(*UploadFileRequest_Chunk)(nil),
+		(*UploadFileRequest_EncryptedInfo)(nil),
+	}
+	file_internal_app_proto_secrets_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*DownloadFileResponse_Info)(nil),
+		(*DownloadFileResponse_Chunk)(nil),
+		(*DownloadFileResponse_EncryptedInfo)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_app_proto_secrets_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_app_proto_secrets_proto_goTypes,
 		DependencyIndexes: file_internal_app_proto_secrets_proto_depIdxs,
+		EnumInfos:         file_internal_app_proto_secrets_proto_enumTypes,
 		MessageInfos:      file_internal_app_proto_secrets_proto_msgTypes,
 	}.Build()
 	File_internal_app_proto_secrets_proto = out.File
@@ -22,12 +22,63 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SecretsClient interface {
-	SavePassword(ctx context.Context, in *SavePasswordRequest, opts ...grpc.CallOption) (*Empty, error)
+	// PutSecret creates secret of any type if version is 0 and updates it otherwise
+	PutSecret(ctx context.Context, in *PutSecretRequest, opts ...grpc.CallOption) (*PutSecretResponse, error)
+	// GetSecret returns card numbers masked and without ccv
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretResponse, error)
+	// RevealSecret returns secret without masking, every call is audited
+	RevealSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretResponse, error)
+	// per type RPCs are kept for existing clients
+	SavePassword(ctx context.Context, in *SavePasswordRequest, opts ...grpc.CallOption) (*PutSecretResponse, error)
 	GetPassword(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
 	SaveCard(ctx context.Context, in *SaveCardRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetCard(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*CardResponse, error)
+	RevealCard(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*CardResponse, error)
 	SaveText(ctx context.Context, in *SaveTextRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetText(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*TextResponse, error)
+	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	UpdateText(ctx context.Context, in *UpdateTextRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	RestoreSecret(ctx context.Context, in *TrashedSecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	PurgeSecret(ctx context.Context, in *TrashedSecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*RevisionResponse, error)
+	RestoreRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	// UploadFile expects FileInfo in the first message and file content in the following ones
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (Secrets_UploadFileClient, error)
+	// DownloadFile sends FileInfo in the first message and file content in the following ones
+	DownloadFile(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (Secrets_DownloadFileClient, error)
+	// ImportTOTP saves TOTP secret parsed from otpauth:// URI
+	ImportTOTP(ctx context.Context, in *ImportTOTPRequest, opts ...grpc.CallOption) (*PutSecretResponse, error)
+	// GetTOTPCode returns the current code of TOTP secret without revealing its seed
+	GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*TOTPCodeResponse, error)
+	// SecurityReport scans all passwords and cards of the user for reused, weak and old passwords and expiring cards
+	SecurityReport(ctx context.Context, in *SecurityReportRequest, opts ...grpc.CallOption) (*SecurityReportResponse, error)
+	// ListExpiring returns secrets that are overdue or due within the window, ordered by due date
+	ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringResponse, error)
+	// FindByURL returns passwords with urls matching the url, every match is audited
+	FindByURL(ctx context.Context, in *FindByURLRequest, opts ...grpc.CallOption) (*FindByURLResponse, error)
+	// ShareSecret gives another user read-only or read-write access to own secret,
+	// sharing with the same user again changes the permission
+	ShareSecret(ctx context.Context, in *ShareSecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ListShares returns users that have access to own secret
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	// ListSharedWithMe returns secrets that other users shared with the user.
+	// They are read with shared_id in GetSecret, RevealSecret and DownloadFile and updated with shared_id in PutSecret
+	ListSharedWithMe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error)
+	// CreateShareLink saves snapshot of the secret that can be read with returned token
+	// without an account, the server doesn't keep the token
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	// RedeemShareLink returns snapshot of the secret by token, it doesn't require authentication
+	RedeemShareLink(ctx context.Context, in *RedeemShareLinkRequest, opts ...grpc.CallOption) (*RedeemShareLinkResponse, error)
+	// RotateVaultKey replaces data key of the vault, secrets are reencrypted with the new key in the background
+	RotateVaultKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// DeleteVaultKey deletes data keys of the vault, its secrets can't be decrypted anymore
+	DeleteVaultKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type secretsClient struct {
@@ -38,8 +89,35 @@ func NewSecretsClient(cc grpc.ClientConnInterface) SecretsClient {
 	return &secretsClient{cc}
 }
 
-func (c *secretsClient) SavePassword(ctx context.Context, in *SavePasswordRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *secretsClient) PutSecret(ctx context.Context, in *PutSecretRequest, opts ...grpc.CallOption) (*PutSecretResponse, error) {
+	out := new(PutSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/PutSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretResponse, error) {
+	out := new(SecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RevealSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretResponse, error) {
+	out := new(SecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/RevealSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) SavePassword(ctx context.Context, in *SavePasswordRequest, opts ...grpc.CallOption) (*PutSecretResponse, error) {
+	out := new(PutSecretResponse)
 	err := c.cc.Invoke(ctx, "/Secrets/SavePassword", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -47,203 +125,1186 @@ func (c *secretsClient) SavePassword(ctx context.Context, in *SavePasswordReques
 	return out, nil
 }
 
-func (c *secretsClient) GetPassword(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
-	out := new(PasswordResponse)
-	err := c.cc.Invoke(ctx, "/Secrets/GetPassword", in, out, opts...)
-	if err != nil {
+func (c *secretsClient) GetPassword(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	out := new(PasswordResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) SaveCard(ctx context.Context, in *SaveCardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/SaveCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) GetCard(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*CardResponse, error) {
+	out := new(CardResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RevealCard(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*CardResponse, error) {
+	out := new(CardResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/RevealCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) SaveText(ctx context.Context, in *SaveTextRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/SaveText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) GetText(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*TextResponse, error) {
+	out := new(TextResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/UpdatePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/UpdateCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) UpdateText(ctx context.Context, in *UpdateTextRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/UpdateText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RestoreSecret(ctx context.Context, in *TrashedSecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/RestoreSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) PurgeSecret(ctx context.Context, in *TrashedSecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/PurgeSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*RevisionResponse, error) {
+	out := new(RevisionResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RestoreRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (Secrets_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Secrets_ServiceDesc.Streams[0], "/Secrets/UploadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &secretsUploadFileClient{stream}
+	return x, nil
+}
+
+type Secrets_UploadFileClient interface {
+	Send(*UploadFileRequest) error
+	CloseAndRecv() (*FileInfo, error)
+	grpc.ClientStream
+}
+
+type secretsUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *secretsUploadFileClient) Send(m *UploadFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *secretsUploadFileClient) CloseAndRecv() (*FileInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *secretsClient) DownloadFile(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (Secrets_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Secrets_ServiceDesc.Streams[1], "/Secrets/DownloadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &secretsDownloadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Secrets_DownloadFileClient interface {
+	Recv() (*DownloadFileResponse, error)
+	grpc.ClientStream
+}
+
+type secretsDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *secretsDownloadFileClient) Recv() (*DownloadFileResponse, error) {
+	m := new(DownloadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *secretsClient) ImportTOTP(ctx context.Context, in *ImportTOTPRequest, opts ...grpc.CallOption) (*PutSecretResponse, error) {
+	out := new(PutSecretResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ImportTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*TOTPCodeResponse, error) {
+	out := new(TOTPCodeResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/GetTOTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) SecurityReport(ctx context.Context, in *SecurityReportRequest, opts ...grpc.CallOption) (*SecurityReportResponse, error) {
+	out := new(SecurityReportResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/SecurityReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringResponse, error) {
+	out := new(ListExpiringResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListExpiring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) FindByURL(ctx context.Context, in *FindByURLRequest, opts ...grpc.CallOption) (*FindByURLResponse, error) {
+	out := new(FindByURLResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/FindByURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ShareSecret(ctx context.Context, in *ShareSecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/ShareSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/RevokeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListSharedWithMe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error) {
+	out := new(ListSharedWithMeResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/ListSharedWithMe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, "/Secrets/CreateShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RedeemShareLink(ctx context.Context, in *RedeemShareLinkRequest, opts ...grpc.CallOption) (*RedeemShareLinkResponse, error) {
+	out := new(RedeemShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/Secrets/RedeemShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RotateVaultKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/RotateVaultKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) DeleteVaultKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Secrets/DeleteVaultKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecretsServer is the server API for Secrets service.
+// All implementations must embed UnimplementedSecretsServer
+// for forward compatibility
+type SecretsServer interface {
+	// PutSecret creates secret of any type if version is 0 and updates it otherwise
+	PutSecret(context.Context, *PutSecretRequest) (*PutSecretResponse, error)
+	// GetSecret returns card numbers masked and without ccv
+	GetSecret(context.Context, *GetSecretRequest) (*SecretResponse, error)
+	// RevealSecret returns secret without masking, every call is audited
+	RevealSecret(context.Context, *GetSecretRequest) (*SecretResponse, error)
+	// per type RPCs are kept for existing clients
+	SavePassword(context.Context, *SavePasswordRequest) (*PutSecretResponse, error)
+	GetPassword(context.Context, *GetSecretRequest) (*PasswordResponse, error)
+	SaveCard(context.Context, *SaveCardRequest) (*Empty, error)
+	GetCard(context.Context, *GetSecretRequest) (*CardResponse, error)
+	RevealCard(context.Context, *GetSecretRequest) (*CardResponse, error)
+	SaveText(context.Context, *SaveTextRequest) (*Empty, error)
+	GetText(context.Context, *GetSecretRequest) (*TextResponse, error)
+	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdateSecretResponse, error)
+	UpdateCard(context.Context, *UpdateCardRequest) (*UpdateSecretResponse, error)
+	UpdateText(context.Context, *UpdateTextRequest) (*UpdateSecretResponse, error)
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*Empty, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListSecretsResponse, error)
+	RestoreSecret(context.Context, *TrashedSecretRequest) (*Empty, error)
+	PurgeSecret(context.Context, *TrashedSecretRequest) (*Empty, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *RevisionRequest) (*RevisionResponse, error)
+	RestoreRevision(context.Context, *RevisionRequest) (*UpdateSecretResponse, error)
+	// UploadFile expects FileInfo in the first message and file content in the following ones
+	UploadFile(Secrets_UploadFileServer) error
+	// DownloadFile sends FileInfo in the first message and file content in the following ones
+	DownloadFile(*GetSecretRequest, Secrets_DownloadFileServer) error
+	// ImportTOTP saves TOTP secret parsed from otpauth:// URI
+	ImportTOTP(context.Context, *ImportTOTPRequest) (*PutSecretResponse, error)
+	// GetTOTPCode returns the current code of TOTP secret without revealing its seed
+	GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*TOTPCodeResponse, error)
+	// SecurityReport scans all passwords and cards of the user for reused, weak and old passwords and expiring cards
+	SecurityReport(context.Context, *SecurityReportRequest) (*SecurityReportResponse, error)
+	// ListExpiring returns secrets that are overdue or due within the window, ordered by due date
+	ListExpiring(context.Context, *ListExpiringRequest) (*ListExpiringResponse, error)
+	// FindByURL returns passwords with urls matching the url, every match is audited
+	FindByURL(context.Context, *FindByURLRequest) (*FindByURLResponse, error)
+	// ShareSecret gives another user read-only or read-write access to own secret,
+	// sharing with the same user again changes the permission
+	ShareSecret(context.Context, *ShareSecretRequest) (*Empty, error)
+	RevokeShare(context.Context, *RevokeShareRequest) (*Empty, error)
+	// ListShares returns users that have access to own secret
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	// ListSharedWithMe returns secrets that other users shared with the user.
+	// They are read with shared_id in GetSecret, RevealSecret and DownloadFile and updated with shared_id in PutSecret
+	ListSharedWithMe(context.Context, *Empty) (*ListSharedWithMeResponse, error)
+	// CreateShareLink saves snapshot of the secret that can be read with returned token
+	// without an account, the server doesn't keep the token
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error)
+	// RedeemShareLink returns snapshot of the secret by token, it doesn't require authentication
+	RedeemShareLink(context.Context, *RedeemShareLinkRequest) (*RedeemShareLinkResponse, error)
+	// RotateVaultKey replaces data key of the vault, secrets are reencrypted with the new key in the background
+	RotateVaultKey(context.Context, *Empty) (*Empty, error)
+	// DeleteVaultKey deletes data keys of the vault, its secrets can't be decrypted anymore
+	DeleteVaultKey(context.Context, *Empty) (*Empty, error)
+	mustEmbedUnimplementedSecretsServer()
+}
+
+// UnimplementedSecretsServer must be embedded to have forward compatible implementations.
+type UnimplementedSecretsServer struct {
+}
+
+func (UnimplementedSecretsServer) PutSecret(context.Context, *PutSecretRequest) (*PutSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutSecret not implemented")
+}
+func (UnimplementedSecretsServer) GetSecret(context.Context, *GetSecretRequest) (*SecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedSecretsServer) RevealSecret(context.Context, *GetSecretRequest) (*SecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSecret not implemented")
+}
+func (UnimplementedSecretsServer) SavePassword(context.Context, *SavePasswordRequest) (*PutSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePassword not implemented")
+}
+func (UnimplementedSecretsServer) GetPassword(context.Context, *GetSecretRequest) (*PasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassword not implemented")
+}
+func (UnimplementedSecretsServer) SaveCard(context.Context, *SaveCardRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCard not implemented")
+}
+func (UnimplementedSecretsServer) GetCard(context.Context, *GetSecretRequest) (*CardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCard not implemented")
+}
+func (UnimplementedSecretsServer) RevealCard(context.Context, *GetSecretRequest) (*CardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealCard not implemented")
+}
+func (UnimplementedSecretsServer) SaveText(context.Context, *SaveTextRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveText not implemented")
+}
+func (UnimplementedSecretsServer) GetText(context.Context, *GetSecretRequest) (*TextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetText not implemented")
+}
+func (UnimplementedSecretsServer) UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
+}
+func (UnimplementedSecretsServer) UpdateCard(context.Context, *UpdateCardRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
+}
+func (UnimplementedSecretsServer) UpdateText(context.Context, *UpdateTextRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateText not implemented")
+}
+func (UnimplementedSecretsServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedSecretsServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedSecretsServer) ListTrash(context.Context, *ListTrashRequest) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedSecretsServer) RestoreSecret(context.Context, *TrashedSecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSecret not implemented")
+}
+func (UnimplementedSecretsServer) PurgeSecret(context.Context, *TrashedSecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeSecret not implemented")
+}
+func (UnimplementedSecretsServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedSecretsServer) GetRevision(context.Context, *RevisionRequest) (*RevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedSecretsServer) RestoreRevision(context.Context, *RevisionRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedSecretsServer) UploadFile(Secrets_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedSecretsServer) DownloadFile(*GetSecretRequest, Secrets_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedSecretsServer) ImportTOTP(context.Context, *ImportTOTPRequest) (*PutSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTOTP not implemented")
+}
+func (UnimplementedSecretsServer) GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*TOTPCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTOTPCode not implemented")
+}
+func (UnimplementedSecretsServer) SecurityReport(context.Context, *SecurityReportRequest) (*SecurityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecurityReport not implemented")
+}
+func (UnimplementedSecretsServer) ListExpiring(context.Context, *ListExpiringRequest) (*ListExpiringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiring not implemented")
+}
+func (UnimplementedSecretsServer) FindByURL(context.Context, *FindByURLRequest) (*FindByURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByURL not implemented")
+}
+func (UnimplementedSecretsServer) ShareSecret(context.Context, *ShareSecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareSecret not implemented")
+}
+func (UnimplementedSecretsServer) RevokeShare(context.Context, *RevokeShareRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedSecretsServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedSecretsServer) ListSharedWithMe(context.Context, *Empty) (*ListSharedWithMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedSecretsServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedSecretsServer) RedeemShareLink(context.Context, *RedeemShareLinkRequest) (*RedeemShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemShareLink not implemented")
+}
+func (UnimplementedSecretsServer) RotateVaultKey(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateVaultKey not implemented")
+}
+func (UnimplementedSecretsServer) DeleteVaultKey(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVaultKey not implemented")
+}
+func (UnimplementedSecretsServer) mustEmbedUnimplementedSecretsServer() {}
+
+// UnsafeSecretsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecretsServer will
+// result in compilation errors.
+type UnsafeSecretsServer interface {
+	mustEmbedUnimplementedSecretsServer()
+}
+
+func RegisterSecretsServer(s grpc.ServiceRegistrar, srv SecretsServer) {
+	s.RegisterService(&Secrets_ServiceDesc, srv)
+}
+
+func _Secrets_PutSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).PutSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/PutSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).PutSecret(ctx, req.(*PutSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RevealSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RevealSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/RevealSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RevealSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_SavePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).SavePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/SavePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).SavePassword(ctx, req.(*SavePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_GetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetPassword(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_SaveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).SaveCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/SaveCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).SaveCard(ctx, req.(*SaveCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_GetCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetCard(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RevealCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RevealCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/RevealCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RevealCard(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_SaveText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).SaveText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/SaveText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).SaveText(ctx, req.(*SaveTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_GetText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetText(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).UpdatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/UpdatePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).UpdatePassword(ctx, req.(*UpdatePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).UpdateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/UpdateCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).UpdateCard(ctx, req.(*UpdateCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_UpdateText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).UpdateText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/UpdateText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).UpdateText(ctx, req.(*UpdateTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ListTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RestoreSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashedSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RestoreSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/RestoreSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RestoreSecret(ctx, req.(*TrashedSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_PurgeSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashedSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).PurgeSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/PurgeSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).PurgeSecret(ctx, req.(*TrashedSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SecretsServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetRevision(ctx, req.(*RevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *secretsClient) SaveCard(ctx context.Context, in *SaveCardRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/Secrets/SaveCard", in, out, opts...)
-	if err != nil {
+func _Secrets_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SecretsServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RestoreRevision(ctx, req.(*RevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *secretsClient) GetCard(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*CardResponse, error) {
-	out := new(CardResponse)
-	err := c.cc.Invoke(ctx, "/Secrets/GetCard", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func _Secrets_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SecretsServer).UploadFile(&secretsUploadFileServer{stream})
 }
 
-func (c *secretsClient) SaveText(ctx context.Context, in *SaveTextRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/Secrets/SaveText", in, out, opts...)
-	if err != nil {
+type Secrets_UploadFileServer interface {
+	SendAndClose(*FileInfo) error
+	Recv() (*UploadFileRequest, error)
+	grpc.ServerStream
+}
+
+type secretsUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *secretsUploadFileServer) SendAndClose(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *secretsUploadFileServer) Recv() (*UploadFileRequest, error) {
+	m := new(UploadFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *secretsClient) GetText(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*TextResponse, error) {
-	out := new(TextResponse)
-	err := c.cc.Invoke(ctx, "/Secrets/GetText", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _Secrets_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSecretRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(SecretsServer).DownloadFile(m, &secretsDownloadFileServer{stream})
 }
 
-// SecretsServer is the server API for Secrets service.
-// All implementations must embed UnimplementedSecretsServer
-// for forward compatibility
-type SecretsServer interface {
-	SavePassword(context.Context, *SavePasswordRequest) (*Empty, error)
-	GetPassword(context.Context, *GetSecretRequest) (*PasswordResponse, error)
-	SaveCard(context.Context, *SaveCardRequest) (*Empty, error)
-	GetCard(context.Context, *GetSecretRequest) (*CardResponse, error)
-	SaveText(context.Context, *SaveTextRequest) (*Empty, error)
-	GetText(context.Context, *GetSecretRequest) (*TextResponse, error)
-	mustEmbedUnimplementedSecretsServer()
+type Secrets_DownloadFileServer interface {
+	Send(*DownloadFileResponse) error
+	grpc.ServerStream
 }
 
-// UnimplementedSecretsServer must be embedded to have forward compatible implementations.
-type UnimplementedSecretsServer struct {
+type secretsDownloadFileServer struct {
+	grpc.ServerStream
 }
 
-func (UnimplementedSecretsServer) SavePassword(context.Context, *SavePasswordRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SavePassword not implemented")
+func (x *secretsDownloadFileServer) Send(m *DownloadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedSecretsServer) GetPassword(context.Context, *GetSecretRequest) (*PasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPassword not implemented")
+
+func _Secrets_ImportTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ImportTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ImportTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ImportTOTP(ctx, req.(*ImportTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSecretsServer) SaveCard(context.Context, *SaveCardRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveCard not implemented")
+
+func _Secrets_GetTOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetTOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/GetTOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetTOTPCode(ctx, req.(*GetTOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSecretsServer) GetCard(context.Context, *GetSecretRequest) (*CardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCard not implemented")
+
+func _Secrets_SecurityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).SecurityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/SecurityReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).SecurityReport(ctx, req.(*SecurityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSecretsServer) SaveText(context.Context, *SaveTextRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveText not implemented")
+
+func _Secrets_ListExpiring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListExpiring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ListExpiring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListExpiring(ctx, req.(*ListExpiringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSecretsServer) GetText(context.Context, *GetSecretRequest) (*TextResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetText not implemented")
+
+func _Secrets_FindByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).FindByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/FindByURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).FindByURL(ctx, req.(*FindByURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSecretsServer) mustEmbedUnimplementedSecretsServer() {}
 
-// UnsafeSecretsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SecretsServer will
-// result in compilation errors.
-type UnsafeSecretsServer interface {
-	mustEmbedUnimplementedSecretsServer()
+func _Secrets_ShareSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ShareSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/ShareSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ShareSecret(ctx, req.(*ShareSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSecretsServer(s grpc.ServiceRegistrar, srv SecretsServer) {
-	s.RegisterService(&Secrets_ServiceDesc, srv)
+func _Secrets_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Secrets/RevokeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_SavePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SavePasswordRequest)
+func _Secrets_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).SavePassword(ctx, in)
+		return srv.(SecretsServer).ListShares(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/SavePassword",
+		FullMethod: "/Secrets/ListShares",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).SavePassword(ctx, req.(*SavePasswordRequest))
+		return srv.(SecretsServer).ListShares(ctx, req.(*ListSharesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_GetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSecretRequest)
+func _Secrets_ListSharedWithMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).GetPassword(ctx, in)
+		return srv.(SecretsServer).ListSharedWithMe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/GetPassword",
+		FullMethod: "/Secrets/ListSharedWithMe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).GetPassword(ctx, req.(*GetSecretRequest))
+		return srv.(SecretsServer).ListSharedWithMe(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_SaveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveCardRequest)
+func _Secrets_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).SaveCard(ctx, in)
+		return srv.(SecretsServer).CreateShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/SaveCard",
+		FullMethod: "/Secrets/CreateShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).SaveCard(ctx, req.(*SaveCardRequest))
+		return srv.(SecretsServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_GetCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSecretRequest)
+func _Secrets_RedeemShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).GetCard(ctx, in)
+		return srv.(SecretsServer).RedeemShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/GetCard",
+		FullMethod: "/Secrets/RedeemShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).GetCard(ctx, req.(*GetSecretRequest))
+		return srv.(SecretsServer).RedeemShareLink(ctx, req.(*RedeemShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_SaveText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveTextRequest)
+func _Secrets_RotateVaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).SaveText(ctx, in)
+		return srv.(SecretsServer).RotateVaultKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/SaveText",
+		FullMethod: "/Secrets/RotateVaultKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).SaveText(ctx, req.(*SaveTextRequest))
+		return srv.(SecretsServer).RotateVaultKey(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Secrets_GetText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSecretRequest)
+func _Secrets_DeleteVaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SecretsServer).GetText(ctx, in)
+		return srv.(SecretsServer).DeleteVaultKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Secrets/GetText",
+		FullMethod: "/Secrets/DeleteVaultKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SecretsServer).GetText(ctx, req.(*GetSecretRequest))
+		return srv.(SecretsServer).DeleteVaultKey(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -255,6 +1316,18 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "Secrets",
 	HandlerType: (*SecretsServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PutSecret",
+			Handler:    _Secrets_PutSecret_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _Secrets_GetSecret_Handler,
+		},
+		{
+			MethodName: "RevealSecret",
+			Handler:    _Secrets_RevealSecret_Handler,
+		},
 		{
 			MethodName: "SavePassword",
 			Handler:    _Secrets_SavePassword_Handler,
@@ -271,6 +1344,10 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCard",
 			Handler:    _Secrets_GetCard_Handler,
 		},
+		{
+			MethodName: "RevealCard",
+			Handler:    _Secrets_RevealCard_Handler,
+		},
 		{
 			MethodName: "SaveText",
 			Handler:    _Secrets_SaveText_Handler,
@@ -279,7 +1356,114 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetText",
 			Handler:    _Secrets_GetText_Handler,
 		},
+		{
+			MethodName: "UpdatePassword",
+			Handler:    _Secrets_UpdatePassword_Handler,
+		},
+		{
+			MethodName: "UpdateCard",
+			Handler:    _Secrets_UpdateCard_Handler,
+		},
+		{
+			MethodName: "UpdateText",
+			Handler:    _Secrets_UpdateText_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _Secrets_ListSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _Secrets_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _Secrets_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreSecret",
+			Handler:    _Secrets_RestoreSecret_Handler,
+		},
+		{
+			MethodName: "PurgeSecret",
+			Handler:    _Secrets_PurgeSecret_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _Secrets_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _Secrets_GetRevision_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _Secrets_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "ImportTOTP",
+			Handler:    _Secrets_ImportTOTP_Handler,
+		},
+		{
+			MethodName: "GetTOTPCode",
+			Handler:    _Secrets_GetTOTPCode_Handler,
+		},
+		{
+			MethodName: "SecurityReport",
+			Handler:    _Secrets_SecurityReport_Handler,
+		},
+		{
+			MethodName: "ListExpiring",
+			Handler:    _Secrets_ListExpiring_Handler,
+		},
+		{
+			MethodName: "FindByURL",
+			Handler:    _Secrets_FindByURL_Handler,
+		},
+		{
+			MethodName: "ShareSecret",
+			Handler:    _Secrets_ShareSecret_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _Secrets_RevokeShare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _Secrets_ListShares_Handler,
+		},
+		{
+			MethodName: "ListSharedWithMe",
+			Handler:    _Secrets_ListSharedWithMe_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _Secrets_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "RedeemShareLink",
+			Handler:    _Secrets_RedeemShareLink_Handler,
+		},
+		{
+			MethodName: "RotateVaultKey",
+			Handler:    _Secrets_RotateVaultKey_Handler,
+		},
+		{
+			MethodName: "DeleteVaultKey",
+			Handler:    _Secrets_DeleteVaultKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _Secrets_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _Secrets_DownloadFile_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/proto/secrets.proto",
 }
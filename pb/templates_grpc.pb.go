@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/app/proto/templates.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TemplatesClient is the client API for Templates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TemplatesClient interface {
+	CreateTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	UpdateTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	GetTemplate(ctx context.Context, in *TemplateIDRequest, opts ...grpc.CallOption) (*Template, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	DeleteTemplate(ctx context.Context, in *TemplateIDRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+}
+
+type templatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTemplatesClient(cc grpc.ClientConnInterface) TemplatesClient {
+	return &templatesClient{cc}
+}
+
+func (c *templatesClient) CreateTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/Templates/CreateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesClient) UpdateTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/Templates/UpdateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesClient) GetTemplate(ctx context.Context, in *TemplateIDRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/Templates/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/Templates/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesClient) DeleteTemplate(ctx context.Context, in *TemplateIDRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Templates/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TemplatesServer is the server API for Templates service.
+// All implementations must embed UnimplementedTemplatesServer
+// for forward compatibility
+type TemplatesServer interface {
+	CreateTemplate(context.Context, *TemplateRequest) (*Template, error)
+	UpdateTemplate(context.Context, *TemplateRequest) (*Template, error)
+	GetTemplate(context.Context, *TemplateIDRequest) (*Template, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	DeleteTemplate(context.Context, *TemplateIDRequest) (*DeleteTemplateResponse, error)
+	mustEmbedUnimplementedTemplatesServer()
+}
+
+// UnimplementedTemplatesServer must be embedded to have forward compatible implementations.
+type UnimplementedTemplatesServer struct {
+}
+
+func (UnimplementedTemplatesServer) CreateTemplate(context.Context, *TemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedTemplatesServer) UpdateTemplate(context.Context, *TemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedTemplatesServer) GetTemplate(context.Context, *TemplateIDRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedTemplatesServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedTemplatesServer) DeleteTemplate(context.Context, *TemplateIDRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedTemplatesServer) mustEmbedUnimplementedTemplatesServer() {}
+
+// UnsafeTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TemplatesServer will
+// result in compilation errors.
+type UnsafeTemplatesServer interface {
+	mustEmbedUnimplementedTemplatesServer()
+}
+
+func RegisterTemplatesServer(s grpc.ServiceRegistrar, srv TemplatesServer) {
+	s.RegisterService(&Templates_ServiceDesc, srv)
+}
+
+func _Templates_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Templates/CreateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServer).CreateTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Templates_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Templates/UpdateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServer).UpdateTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Templates_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Templates/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServer).GetTemplate(ctx, req.(*TemplateIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Templates_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Templates/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Templates_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Templates/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServer).DeleteTemplate(ctx, req.(*TemplateIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Templates_ServiceDesc is the grpc.ServiceDesc for Templates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Templates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Templates",
+	HandlerType: (*TemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _Templates_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Templates_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _Templates_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _Templates_ListTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Templates_DeleteTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/proto/templates.proto",
+}